@@ -9,10 +9,19 @@ import (
 )
 
 type Mongo struct {
-	DSN                    string        `default:"mongodb://127.0.0.1:27017" env:"MONGO_DSN"`
-	ToDoDatabaseName       string        `default:"todo" env:"MONGO_PROJECT_DATABASE"`
-	ProjectsCollectionName string        `default:"projects" evn:"MONGO_PROJECTS_COLLECTION"`
-	ConnectTimeout         time.Duration `default:"3s" env:"MONGO_CONNECT_TIMEOUT"`
+	DSN                           string        `default:"mongodb://127.0.0.1:27017" env:"MONGO_DSN"`
+	ToDoDatabaseName              string        `default:"todo" env:"MONGO_PROJECT_DATABASE"`
+	ProjectsCollectionName        string        `default:"projects" evn:"MONGO_PROJECTS_COLLECTION"`
+	CommentsCollectionName        string        `default:"comments" env:"MONGO_COMMENTS_COLLECTION"`
+	TemplatesCollectionName       string        `default:"templates" env:"MONGO_TEMPLATES_COLLECTION"`
+	TagsCollectionName            string        `default:"tags" env:"MONGO_TAGS_COLLECTION"`
+	TimeEntriesCollectionName     string        `default:"time_entries" env:"MONGO_TIME_ENTRIES_COLLECTION"`
+	PreferencesCollectionName     string        `default:"preferences" env:"MONGO_PREFERENCES_COLLECTION"`
+	ServiceAccountsCollectionName string        `default:"service_accounts" env:"MONGO_SERVICE_ACCOUNTS_COLLECTION"`
+	InvitationsCollectionName     string        `default:"invitations" env:"MONGO_INVITATIONS_COLLECTION"`
+	ShareLinksCollectionName      string        `default:"share_links" env:"MONGO_SHARE_LINKS_COLLECTION"`
+	TeamsCollectionName           string        `default:"teams" env:"MONGO_TEAMS_COLLECTION"`
+	ConnectTimeout                time.Duration `default:"3s" env:"MONGO_CONNECT_TIMEOUT"`
 }
 
 type Nats struct {
@@ -20,13 +29,92 @@ type Nats struct {
 	UserWorkerGroup string `default:"user-worker-group" env:"NATS_USER_WORKER_GROUP"`
 }
 
+type Attachments struct {
+	Dir                 string   `default:"./data/attachments" env:"ATTACHMENTS_DIR"`
+	MaxSize             int64    `default:"10485760" env:"ATTACHMENTS_MAX_SIZE"`
+	AllowedContentTypes []string `default:"[image/png, image/jpeg, image/gif, application/pdf, text/plain]" env:"ATTACHMENTS_ALLOWED_CONTENT_TYPES"`
+}
+
+type Mentions struct {
+	RejectInaccessible bool `default:"false" env:"MENTIONS_REJECT_INACCESSIBLE"`
+}
+
+type Invitations struct {
+	TTL time.Duration `default:"168h" env:"INVITATIONS_TTL"`
+}
+
+type ShareLinks struct {
+	DefaultTTL time.Duration `default:"168h" env:"SHARE_LINKS_DEFAULT_TTL"`
+	MaxTTL     time.Duration `default:"2160h" env:"SHARE_LINKS_MAX_TTL"`
+}
+
+// Auth configures the JWT authentication. Mode is one of disabled,
+// transitional or enforce. Tokens are verified with the HMAC secret and the
+// keys of the JWKS file. Tokens without an exp claim are rejected unless
+// AllowTokensWithoutExpiry is set.
+type Auth struct {
+	Mode                     string        `default:"disabled" env:"AUTH_MODE"`
+	JWKSFile                 string        `default:"" env:"AUTH_JWKS_FILE"`
+	HMACSecret               string        `default:"" env:"AUTH_HMAC_SECRET"`
+	Issuer                   string        `default:"" env:"AUTH_ISSUER"`
+	Audience                 string        `default:"" env:"AUTH_AUDIENCE"`
+	Leeway                   time.Duration `default:"30s" env:"AUTH_LEEWAY"`
+	AllowTokensWithoutExpiry bool          `default:"false" env:"AUTH_ALLOW_TOKENS_WITHOUT_EXPIRY"`
+}
+
+// TLS enables TLS on the gRPC listener when CertFile is set. Client
+// certificates are verified against ClientCAFile, which RequireClientCert
+// needs. With ClientCertIdentity the common name of the client certificate
+// authenticates the caller.
+type TLS struct {
+	CertFile           string        `default:"" env:"TLS_CERT_FILE"`
+	KeyFile            string        `default:"" env:"TLS_KEY_FILE"`
+	ClientCAFile       string        `default:"" env:"TLS_CLIENT_CA_FILE"`
+	RequireClientCert  bool          `default:"false" env:"TLS_REQUIRE_CLIENT_CERT"`
+	ClientCertIdentity bool          `default:"false" env:"TLS_CLIENT_CERT_IDENTITY"`
+	ReloadInterval     time.Duration `default:"10s" env:"TLS_RELOAD_INTERVAL"`
+}
+
+// RateLimit limits the requests of every user per method to Rate requests
+// per second with bursts of Burst. Methods overrides the limit of single
+// methods as "AddTask=2:10". A zero rate disables the limit.
+type RateLimit struct {
+	Rate    float64  `default:"20" env:"RATE_LIMIT_RATE"`
+	Burst   int      `default:"40" env:"RATE_LIMIT_BURST"`
+	Methods []string `default:"[AddTask=5:20]" env:"RATE_LIMIT_METHODS"`
+}
+
+// Quotas limit the resources of the users. Zero disables a quota.
+type Quotas struct {
+	ProjectsPerOwner       int `default:"500" env:"QUOTAS_PROJECTS_PER_OWNER"`
+	TasksPerProject        int `default:"5000" env:"QUOTAS_TASKS_PER_PROJECT"`
+	ParticipantsPerProject int `default:"100" env:"QUOTAS_PARTICIPANTS_PER_PROJECT"`
+}
+
+// Authorization rules from PolicyFile are applied on top of the default
+// policy.
+type Authorization struct {
+	PolicyFile string `default:"" env:"AUTHZ_POLICY_FILE"`
+}
+
 type Config struct {
 	ServiceName     string        `default:"todo-sv" env:"SERVICE_NAME"`
 	LogLevel        string        `default:"debug" env:"LOG_LEVEL"`
+	Host            string        `default:"127.0.0.1" env:"HOST"`
 	Port            string        `default:"8080" env:"PORT"`
+	HTTPPort        string        `default:"" env:"HTTP_PORT"`
 	ShutdownTimeout time.Duration `default:"5s" env:"SHUTDOWN_TIMEOUT"`
 	Mongo           Mongo
 	Nats            Nats
+	Attachments     Attachments
+	Mentions        Mentions
+	Invitations     Invitations
+	ShareLinks      ShareLinks
+	Auth            Auth
+	TLS             TLS
+	Authorization   Authorization
+	RateLimit       RateLimit
+	Quotas          Quotas
 }
 
 func mustLoadConfig() Config {
@@ -2,20 +2,41 @@ package main
 
 import (
 	"context"
-	"fmt"
+	"crypto/tls"
 	"net"
+	"net/http"
+	"time"
 
+	"github.com/sladonia/todo-sv/internal/auth"
+	"github.com/sladonia/todo-sv/internal/authz"
 	"github.com/sladonia/todo-sv/internal/logger"
 	"github.com/sladonia/todo-sv/internal/mongodb"
+	"github.com/sladonia/todo-sv/internal/ratelimit"
+	"github.com/sladonia/todo-sv/internal/tlsconfig"
 	"github.com/sladonia/todo-sv/internal/todo"
 	"github.com/sladonia/todo-sv/pkg/todopb"
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 )
 
-func newGRPCServer(todoService todopb.ToDoServiceServer) *grpc.Server {
-	s := grpc.NewServer()
+func newGRPCServer(
+	todoService todopb.ToDoServiceServer,
+	authInterceptor *auth.Interceptor,
+	rateLimitInterceptor *ratelimit.Interceptor,
+	tlsConfig *tls.Config,
+) *grpc.Server {
+	opts := []grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(authInterceptor.Unary(), rateLimitInterceptor.Unary()),
+		grpc.ChainStreamInterceptor(authInterceptor.Stream(), rateLimitInterceptor.Stream()),
+	}
+
+	if tlsConfig != nil {
+		opts = append(opts, grpc.Creds(credentials.NewTLS(tlsConfig)))
+	}
+
+	s := grpc.NewServer(opts...)
 
 	todopb.RegisterToDoServiceServer(s, todoService)
 
@@ -23,7 +44,7 @@ func newGRPCServer(todoService todopb.ToDoServiceServer) *grpc.Server {
 }
 
 func mustCreateListener(log *zap.Logger, config Config) net.Listener {
-	lis, err := net.Listen("tcp", fmt.Sprintf("127.0.0.1:%s", config.Port))
+	lis, err := net.Listen("tcp", net.JoinHostPort(config.Host, config.Port))
 	if err != nil {
 		log.Panic("create net listener", zap.Error(err))
 	}
@@ -66,6 +87,20 @@ func newUserEventDistributor(
 	)
 }
 
+func mustCreateTimeEntryStorage(
+	ctx context.Context,
+	log *zap.Logger,
+	db *mongo.Database,
+	config Config,
+) todo.TimeEntryStorage {
+	storage, err := todo.NewTimeEntryStorage(ctx, db, config.Mongo.TimeEntriesCollectionName)
+	if err != nil {
+		log.Panic("create time entry storage", zap.Error(err))
+	}
+
+	return storage
+}
+
 func mustCreatePubSub(log *zap.Logger, config Config) todo.PubSub {
 	pubSub, err := todo.NewNatsPubSub(config.Nats.DSN)
 	if err != nil {
@@ -74,3 +109,188 @@ func mustCreatePubSub(log *zap.Logger, config Config) todo.PubSub {
 
 	return pubSub
 }
+
+func mustCreateBlobStore(log *zap.Logger, config Config) todo.BlobStore {
+	blobStore, err := todo.NewLocalBlobStore(config.Attachments.Dir)
+	if err != nil {
+		log.Panic("create blob store", zap.Error(err))
+	}
+
+	return blobStore
+}
+
+func newAttachmentLimits(config Config) todo.AttachmentLimits {
+	return todo.AttachmentLimits{
+		MaxSize:             config.Attachments.MaxSize,
+		AllowedContentTypes: config.Attachments.AllowedContentTypes,
+	}
+}
+
+// publicMethods are available without authentication.
+var publicMethods = []string{
+	"/todo.ToDoService/GetSharedProject",
+}
+
+func mustCreateAuthInterceptor(
+	log *zap.Logger,
+	config Config,
+	serviceAccountStorage todo.ServiceAccountStorage,
+) *auth.Interceptor {
+	mode, err := auth.ParseMode(config.Auth.Mode)
+	if err != nil {
+		log.Panic("parse auth mode", zap.Error(err))
+	}
+
+	keys := auth.NewKeySet()
+
+	if config.Auth.HMACSecret != "" {
+		keys.AddHMACKey("", []byte(config.Auth.HMACSecret))
+	}
+
+	if config.Auth.JWKSFile != "" {
+		err = keys.LoadJWKS(config.Auth.JWKSFile)
+		if err != nil {
+			log.Panic("load jwks", zap.Error(err))
+		}
+	}
+
+	if mode != auth.ModeDisabled && keys.IsEmpty() {
+		log.Panic("auth is enabled but no keys are configured")
+	}
+
+	verifier := auth.NewVerifier(
+		keys,
+		config.Auth.Issuer,
+		config.Auth.Audience,
+		config.Auth.Leeway,
+		config.Auth.AllowTokensWithoutExpiry,
+	)
+
+	return auth.NewInterceptor(
+		verifier,
+		todo.NewAPIKeyAuthenticator(serviceAccountStorage, log),
+		mode,
+		config.TLS.ClientCertIdentity,
+		publicMethods,
+		log,
+	)
+}
+
+// mustCreateTLSConfig returns nil when TLS is not configured.
+func mustCreateTLSConfig(log *zap.Logger, config Config) *tls.Config {
+	if config.TLS.RequireClientCert && config.TLS.ClientCAFile == "" {
+		log.Panic("tls client certificates are required but no client ca file is set")
+	}
+
+	if config.TLS.CertFile == "" {
+		return nil
+	}
+
+	reloader, err := tlsconfig.NewReloader(
+		config.TLS.CertFile,
+		config.TLS.KeyFile,
+		config.TLS.ClientCAFile,
+		config.TLS.ReloadInterval,
+		log,
+	)
+	if err != nil {
+		log.Panic("load tls certificates", zap.Error(err))
+	}
+
+	return reloader.ServerConfig(config.TLS.RequireClientCert)
+}
+
+func mustCreateRateLimitInterceptor(log *zap.Logger, config Config) *ratelimit.Interceptor {
+	methodLimits := make(map[string]ratelimit.Limit, len(config.RateLimit.Methods))
+
+	for _, s := range config.RateLimit.Methods {
+		method, limit, err := ratelimit.ParseMethodLimit(s)
+		if err != nil {
+			log.Panic("parse method rate limit", zap.Error(err))
+		}
+
+		methodLimits[method] = limit
+	}
+
+	limiter := ratelimit.NewLimiter(
+		ratelimit.Limit{Rate: config.RateLimit.Rate, Burst: config.RateLimit.Burst},
+		methodLimits,
+	)
+
+	return ratelimit.NewInterceptor(limiter, log)
+}
+
+func newQuotas(config Config) todo.Quotas {
+	return todo.Quotas{
+		ProjectsPerOwner:       config.Quotas.ProjectsPerOwner,
+		TasksPerProject:        config.Quotas.TasksPerProject,
+		ParticipantsPerProject: config.Quotas.ParticipantsPerProject,
+	}
+}
+
+func mustCreateAuthorizer(log *zap.Logger, config Config) authz.Authorizer {
+	policies := []authz.Policy{authz.DefaultPolicy}
+
+	if config.Authorization.PolicyFile != "" {
+		policy, err := authz.LoadPolicy(config.Authorization.PolicyFile)
+		if err != nil {
+			log.Panic("load authorization policy", zap.Error(err))
+		}
+
+		policies = append(policies, policy)
+	}
+
+	authorizer, err := authz.NewPolicyAuthorizer(policies...)
+	if err != nil {
+		log.Panic("create authorizer", zap.Error(err))
+	}
+
+	return authorizer
+}
+
+func newMentionOptions(config Config) todo.MentionOptions {
+	return todo.MentionOptions{
+		RejectInaccessible: config.Mentions.RejectInaccessible,
+	}
+}
+
+func newShareLinkOptions(config Config) todo.ShareLinkOptions {
+	return todo.ShareLinkOptions{
+		DefaultTTL: config.ShareLinks.DefaultTTL,
+		MaxTTL:     config.ShareLinks.MaxTTL,
+	}
+}
+
+// newHTTPServer returns nil when the HTTP port is not configured.
+// newHTTPServer serves the share links when HTTPPort is set. It uses the TLS
+// config of the gRPC server, if any, and the rate limit of GetSharedProject.
+func newHTTPServer(
+	config Config,
+	todoService todopb.ToDoServiceServer,
+	rateLimitInterceptor *ratelimit.Interceptor,
+	tlsConfig *tls.Config,
+	log *zap.Logger,
+) *http.Server {
+	if config.HTTPPort == "" {
+		return nil
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle(
+		todo.SharePathPrefix,
+		rateLimitInterceptor.HTTP("GetSharedProject", todo.NewShareHandler(todoService, log)),
+	)
+
+	return &http.Server{
+		Addr:              net.JoinHostPort(config.Host, config.HTTPPort),
+		Handler:           mux,
+		TLSConfig:         tlsConfig,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+}
+
+func newInvitationOptions(config Config) todo.InvitationOptions {
+	return todo.InvitationOptions{
+		TTL: config.Invitations.TTL,
+	}
+}
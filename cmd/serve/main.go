@@ -3,6 +3,7 @@ package main
 import (
 	"context"
 	"net"
+	"net/http"
 	"os/signal"
 	"syscall"
 
@@ -22,16 +23,59 @@ func main() {
 	defer log.Sync()
 
 	var (
-		listener         = mustCreateListener(log, config)
-		db               = mustConnectToMongo(ctx, log, config)
-		pubSub           = mustCreatePubSub(log, config)
-		eventDistributor = newUserEventDistributor(log, config, pubSub, pubSub)
-		projectStorage   = todo.NewStorage(db, config.Mongo.ProjectsCollectionName)
-		todoService      = todo.NewService(log, projectStorage, pubSub)
-		grpcServer       = newGRPCServer(todoService)
+		listener              = mustCreateListener(log, config)
+		db                    = mustConnectToMongo(ctx, log, config)
+		pubSub                = mustCreatePubSub(log, config)
+		eventDistributor      = newUserEventDistributor(log, config, pubSub, pubSub)
+		projectStorage        = todo.NewStorage(db, config.Mongo.ProjectsCollectionName)
+		commentStorage        = todo.NewCommentStorage(db, config.Mongo.CommentsCollectionName)
+		templateStorage       = todo.NewTemplateStorage(db, config.Mongo.TemplatesCollectionName)
+		tagStorage            = todo.NewTagStorage(db, config.Mongo.TagsCollectionName)
+		timeEntryStorage      = mustCreateTimeEntryStorage(ctx, log, db, config)
+		preferencesStorage    = todo.NewPreferencesStorage(db, config.Mongo.PreferencesCollectionName)
+		serviceAccountStorage = todo.NewServiceAccountStorage(db, config.Mongo.ServiceAccountsCollectionName)
+		invitationStorage     = todo.NewInvitationStorage(db, config.Mongo.InvitationsCollectionName)
+		shareLinkStorage      = todo.NewShareLinkStorage(db, config.Mongo.ShareLinksCollectionName)
+		teamStorage           = todo.NewTeamStorage(db, config.Mongo.TeamsCollectionName)
+		blobStore             = mustCreateBlobStore(log, config)
 	)
 
-	run(ctx, log, config, grpcServer, listener, eventDistributor)
+	todoService := todo.NewService(
+		log,
+		projectStorage,
+		commentStorage,
+		templateStorage,
+		tagStorage,
+		timeEntryStorage,
+		preferencesStorage,
+		serviceAccountStorage,
+		invitationStorage,
+		shareLinkStorage,
+		teamStorage,
+		blobStore,
+		newAttachmentLimits(config),
+		newMentionOptions(config),
+		newInvitationOptions(config),
+		newShareLinkOptions(config),
+		newQuotas(config),
+		mustCreateAuthorizer(log, config),
+		pubSub,
+	)
+	var (
+		rateLimitInterceptor = mustCreateRateLimitInterceptor(log, config)
+		tlsConfig            = mustCreateTLSConfig(log, config)
+	)
+
+	grpcServer := newGRPCServer(
+		todoService,
+		mustCreateAuthInterceptor(log, config, serviceAccountStorage),
+		rateLimitInterceptor,
+		tlsConfig,
+	)
+
+	httpServer := newHTTPServer(config, todoService, rateLimitInterceptor, tlsConfig, log)
+
+	run(ctx, log, config, grpcServer, httpServer, listener, eventDistributor)
 
 }
 
@@ -40,16 +84,30 @@ func run(
 	log *zap.Logger,
 	config Config,
 	grpcServer *grpc.Server,
+	httpServer *http.Server,
 	lis net.Listener,
 	eventDistributor *todo.UserEventsDistributor,
 ) {
 	errCh := make(chan error)
 
 	go func() {
-		log.Info("start listening grpc server", zap.String("port", config.Port))
+		log.Info("start listening grpc server", zap.String("host", config.Host), zap.String("port", config.Port))
 		errCh <- grpcServer.Serve(lis)
 	}()
 
+	if httpServer != nil {
+		go func() {
+			log.Info("start listening http server", zap.String("addr", httpServer.Addr))
+
+			if httpServer.TLSConfig != nil {
+				errCh <- httpServer.ListenAndServeTLS("", "")
+				return
+			}
+
+			errCh <- httpServer.ListenAndServe()
+		}()
+	}
+
 	go func() {
 		log.Info("start event distributor")
 		errCh <- eventDistributor.Start(ctx)
@@ -62,6 +120,14 @@ func run(
 
 		go func() {
 			grpcServer.GracefulStop()
+
+			if httpServer != nil {
+				err := httpServer.Shutdown(shutdownCtx)
+				if err != nil {
+					log.Error("shut down http server", zap.Error(err))
+				}
+			}
+
 			close(doneCh)
 		}()
 
@@ -0,0 +1,89 @@
+package test
+
+import (
+	"context"
+	"time"
+
+	"github.com/sladonia/todo-sv/pkg/todopb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *Suite) TestUpdateProjectMetadata() {
+	ctx := context.Background()
+
+	_, err := s.service.UpdateProject(ctx, &todopb.UpdateProjectRequest{
+		ProjectId:   "3",
+		UserId:      "2",
+		Description: "monthly payments",
+		Color:       "#ff8800",
+		Icon:        "💸",
+		FieldMask: &fieldmaskpb.FieldMask{Paths: []string{
+			todopb.UpdateProjectDescriptionField,
+			todopb.UpdateProjectColorField,
+			todopb.UpdateProjectIconField,
+		}},
+	})
+	s.NoError(err)
+
+	p, err := s.storage.ByID(ctx, "3")
+	s.NoError(err)
+	s.Equal("monthly payments", p.Description)
+	s.Equal("#ff8800", p.Color)
+	s.Equal("💸", p.Icon)
+	s.Equal("different", p.Name)
+}
+
+func (s *Suite) TestArchiveProject() {
+	ctx := context.Background()
+
+	_, err := s.service.ArchiveProject(ctx, &todopb.ArchiveProjectRequest{ProjectId: "3", UserId: "3"})
+	s.Equal(codes.PermissionDenied, status.Code(err))
+
+	_, err = s.service.ArchiveProject(ctx, &todopb.ArchiveProjectRequest{ProjectId: "3", UserId: "2"})
+	s.NoError(err)
+
+	all, err := s.service.AllProjects(ctx, &todopb.AllProjectsRequest{UserId: "3"})
+	s.NoError(err)
+	s.Len(all.Projects, 1)
+	s.Equal("2", all.Projects[0].Id)
+
+	all, err = s.service.AllProjects(ctx, &todopb.AllProjectsRequest{UserId: "3", IncludeArchived: true})
+	s.NoError(err)
+	s.Len(all.Projects, 2)
+
+	_, err = s.service.AddTask(ctx, &todopb.AddTaskRequest{ProjectId: "3", UserId: "3", Title: "rent"})
+	s.Equal(codes.FailedPrecondition, status.Code(err))
+
+	_, err = s.service.DeleteTask(ctx, &todopb.DeleteTaskRequest{ProjectId: "3", UserId: "3", TaskId: "1"})
+	s.Equal(codes.FailedPrecondition, status.Code(err))
+
+	_, err = s.service.CreateSection(ctx, &todopb.CreateSectionRequest{ProjectId: "3", UserId: "3", Name: "later"})
+	s.Equal(codes.FailedPrecondition, status.Code(err))
+
+	_, err = s.service.AddComment(ctx, &todopb.AddCommentRequest{ProjectId: "3", TaskId: "1", UserId: "3", Text: "paid"})
+	s.Equal(codes.FailedPrecondition, status.Code(err))
+
+	_, err = s.service.StartTimer(ctx, &todopb.StartTimerRequest{ProjectId: "3", TaskId: "1", UserId: "3"})
+	s.Equal(codes.FailedPrecondition, status.Code(err))
+
+	_, err = s.service.AddTimeEntry(ctx, &todopb.AddTimeEntryRequest{
+		ProjectId: "3",
+		TaskId:    "1",
+		UserId:    "3",
+		StartedAt: timestamppb.New(time.Now().Add(-time.Hour)),
+		EndedAt:   timestamppb.Now(),
+	})
+	s.Equal(codes.FailedPrecondition, status.Code(err))
+
+	_, err = s.service.GetProject(ctx, &todopb.GetProjectRequest{ProjectId: "3", UserId: "3"})
+	s.NoError(err)
+
+	_, err = s.service.UnarchiveProject(ctx, &todopb.ArchiveProjectRequest{ProjectId: "3", UserId: "2"})
+	s.NoError(err)
+
+	_, err = s.service.AddTask(ctx, &todopb.AddTaskRequest{ProjectId: "3", UserId: "3", Title: "rent"})
+	s.NoError(err)
+}
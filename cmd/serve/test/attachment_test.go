@@ -0,0 +1,119 @@
+package test
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+
+	"github.com/sladonia/todo-sv/pkg/todopb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *Suite) TestUploadAttachment() {
+	metadata := &todopb.AttachmentMetadata{
+		ProjectId:   "3",
+		TaskId:      "1",
+		UserId:      "3",
+		Name:        "receipt.txt",
+		ContentType: "text/plain",
+	}
+
+	s.Run("success", func() {
+		content := []byte("paid 42 bucks")
+		uploadServer := newMockUploadServer(
+			&todopb.UploadAttachmentRequest{Metadata: metadata, Chunk: content[:4]},
+			&todopb.UploadAttachmentRequest{Chunk: content[4:]},
+		)
+
+		err := s.service.UploadAttachment(uploadServer)
+		s.Require().NoError(err)
+
+		checksum := sha256.Sum256(content)
+		attachment := uploadServer.response
+		s.Equal(int64(len(content)), attachment.Size)
+		s.Equal(hex.EncodeToString(checksum[:]), attachment.Checksum)
+
+		updated, err := s.storage.ByID(context.Background(), "3")
+		s.NoError(err)
+		s.Len(updated.Tasks["1"].Attachments, 1)
+
+		downloadServer := newMockDownloadServer()
+
+		err = s.service.DownloadAttachment(&todopb.DownloadAttachmentRequest{
+			ProjectId:    "3",
+			TaskId:       "1",
+			AttachmentId: attachment.Id,
+			UserId:       "2",
+		}, downloadServer)
+		s.Require().NoError(err)
+
+		var downloaded bytes.Buffer
+		for _, resp := range downloadServer.responses {
+			downloaded.Write(resp.Chunk)
+		}
+
+		s.Equal(attachment.Id, downloadServer.responses[0].Attachment.Id)
+		s.Equal(content, downloaded.Bytes())
+	})
+
+	s.Run("too_large", func() {
+		uploadServer := newMockUploadServer(
+			&todopb.UploadAttachmentRequest{Metadata: metadata},
+			&todopb.UploadAttachmentRequest{Chunk: make([]byte, maxAttachmentSize)},
+			&todopb.UploadAttachmentRequest{Chunk: []byte("1")},
+		)
+
+		err := s.service.UploadAttachment(uploadServer)
+
+		st, ok := status.FromError(err)
+		if !ok {
+			s.FailNow("not a grpc error")
+		}
+
+		s.Equal(codes.ResourceExhausted, st.Code())
+	})
+
+	s.Run("content_type_not_allowed", func() {
+		uploadServer := newMockUploadServer(
+			&todopb.UploadAttachmentRequest{Metadata: &todopb.AttachmentMetadata{
+				ProjectId:   "3",
+				TaskId:      "1",
+				UserId:      "3",
+				Name:        "virus.exe",
+				ContentType: "application/octet-stream",
+			}},
+		)
+
+		err := s.service.UploadAttachment(uploadServer)
+
+		st, ok := status.FromError(err)
+		if !ok {
+			s.FailNow("not a grpc error")
+		}
+
+		s.Equal(codes.InvalidArgument, st.Code())
+	})
+
+	s.Run("permission_denied", func() {
+		uploadServer := newMockUploadServer(
+			&todopb.UploadAttachmentRequest{Metadata: &todopb.AttachmentMetadata{
+				ProjectId:   "3",
+				TaskId:      "1",
+				UserId:      "unexisting",
+				Name:        "receipt.txt",
+				ContentType: "text/plain",
+			}},
+		)
+
+		err := s.service.UploadAttachment(uploadServer)
+
+		st, ok := status.FromError(err)
+		if !ok {
+			s.FailNow("not a grpc error")
+		}
+
+		s.Equal(codes.PermissionDenied, st.Code())
+	})
+}
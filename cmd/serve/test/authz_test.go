@@ -0,0 +1,55 @@
+package test
+
+import (
+	"context"
+
+	"github.com/sladonia/todo-sv/internal/authz"
+	"github.com/sladonia/todo-sv/pkg/todopb"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+// recordingAuthorizer remembers the task ids of the authorized actions.
+type recordingAuthorizer struct {
+	authz.Authorizer
+	taskIDs map[authz.Action]string
+}
+
+func (a *recordingAuthorizer) Authorize(
+	ctx context.Context,
+	subject string,
+	action authz.Action,
+	resource authz.Resource,
+) error {
+	a.taskIDs[action] = resource.TaskID
+
+	return a.Authorizer.Authorize(ctx, subject, action, resource)
+}
+
+func (s *Suite) TestAuthorizeTaskResource() {
+	ctx := context.Background()
+
+	policyAuthorizer, err := authz.NewPolicyAuthorizer(authz.DefaultPolicy)
+	s.Require().NoError(err)
+
+	authorizer := &recordingAuthorizer{Authorizer: policyAuthorizer, taskIDs: make(map[authz.Action]string)}
+	service := s.newServiceWithAuthorizer(authorizer)
+
+	_, err = service.UpdateTask(ctx, &todopb.UpdateTaskRequest{
+		TaskId:     "1",
+		ProjectId:  "3",
+		UserId:     "3",
+		IsFinished: true,
+		FieldMask:  &fieldmaskpb.FieldMask{Paths: []string{todopb.UpdateTaskIsFinishedField}},
+	})
+	s.Require().NoError(err)
+
+	_, err = service.StartTimer(ctx, &todopb.StartTimerRequest{ProjectId: "3", TaskId: "1", UserId: "3"})
+	s.Require().NoError(err)
+
+	_, err = service.DeleteTask(ctx, &todopb.DeleteTaskRequest{ProjectId: "3", TaskId: "1", UserId: "3"})
+	s.Require().NoError(err)
+
+	s.Equal("1", authorizer.taskIDs[authz.ActionUpdateTask])
+	s.Equal("1", authorizer.taskIDs[authz.ActionTrackTime])
+	s.Equal("1", authorizer.taskIDs[authz.ActionDeleteTask])
+}
@@ -0,0 +1,194 @@
+package test
+
+import (
+	"context"
+
+	"github.com/sladonia/todo-sv/pkg/todopb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *Suite) TestAddComment() {
+	ctx := context.Background()
+
+	testCases := []struct {
+		name    string
+		request *todopb.AddCommentRequest
+		errCode codes.Code
+	}{
+		{
+			name: "success",
+			request: &todopb.AddCommentRequest{
+				ProjectId: "3",
+				TaskId:    "1",
+				UserId:    "3",
+				Text:      "paid half of it",
+			},
+			errCode: codes.OK,
+		},
+		{
+			name: "task_not_found",
+			request: &todopb.AddCommentRequest{
+				ProjectId: "3",
+				TaskId:    "unexisting",
+				UserId:    "3",
+				Text:      "paid half of it",
+			},
+			errCode: codes.NotFound,
+		},
+		{
+			name: "permission_denied",
+			request: &todopb.AddCommentRequest{
+				ProjectId: "3",
+				TaskId:    "1",
+				UserId:    "unexisting",
+				Text:      "paid half of it",
+			},
+			errCode: codes.PermissionDenied,
+		},
+		{
+			name: "invalid_request",
+			request: &todopb.AddCommentRequest{
+				ProjectId: "3",
+				TaskId:    "1",
+				UserId:    "3",
+			},
+			errCode: codes.InvalidArgument,
+		},
+	}
+
+	for _, testCase := range testCases {
+		s.Run(testCase.name, func() {
+			res, err := s.service.AddComment(ctx, testCase.request)
+
+			st, ok := status.FromError(err)
+			if !ok {
+				s.FailNow("not a grpc error")
+			}
+
+			s.Equal(testCase.errCode, st.Code())
+
+			if err == nil {
+				stored, err := s.commentStorage.ByID(ctx, res.Id)
+				s.NoError(err)
+				s.Equal(testCase.request.Text, stored.Text)
+				s.Equal(testCase.request.UserId, stored.AuthorId)
+			}
+		})
+	}
+}
+
+func (s *Suite) TestUpdateAndDeleteComment() {
+	ctx := context.Background()
+
+	comment, err := s.service.AddComment(ctx, &todopb.AddCommentRequest{
+		ProjectId: "3",
+		TaskId:    "1",
+		UserId:    "3",
+		Text:      "paid half of it",
+	})
+	s.Require().NoError(err)
+
+	s.Run("update_not_author", func() {
+		_, err := s.service.UpdateComment(ctx, &todopb.UpdateCommentRequest{
+			CommentId: comment.Id,
+			UserId:    "2",
+			Text:      "paid all of it",
+		})
+
+		st, ok := status.FromError(err)
+		if !ok {
+			s.FailNow("not a grpc error")
+		}
+
+		s.Equal(codes.PermissionDenied, st.Code())
+	})
+
+	s.Run("update_success", func() {
+		_, err := s.service.UpdateComment(ctx, &todopb.UpdateCommentRequest{
+			CommentId: comment.Id,
+			UserId:    "3",
+			Text:      "paid all of it",
+		})
+		s.NoError(err)
+
+		updated, err := s.commentStorage.ByID(ctx, comment.Id)
+		s.NoError(err)
+		s.Equal("paid all of it", updated.Text)
+		s.True(updated.Version > comment.Version)
+	})
+
+	s.Run("delete_success", func() {
+		_, err := s.service.DeleteComment(ctx, &todopb.DeleteCommentRequest{
+			CommentId: comment.Id,
+			UserId:    "3",
+		})
+		s.NoError(err)
+
+		_, err = s.service.DeleteComment(ctx, &todopb.DeleteCommentRequest{
+			CommentId: comment.Id,
+			UserId:    "3",
+		})
+
+		st, ok := status.FromError(err)
+		if !ok {
+			s.FailNow("not a grpc error")
+		}
+
+		s.Equal(codes.NotFound, st.Code())
+	})
+}
+
+func (s *Suite) TestListComments() {
+	ctx := context.Background()
+
+	for _, text := range []string{"first", "second", "third"} {
+		_, err := s.service.AddComment(ctx, &todopb.AddCommentRequest{
+			ProjectId: "3",
+			TaskId:    "1",
+			UserId:    "2",
+			Text:      text,
+		})
+		s.Require().NoError(err)
+	}
+
+	firstPage, err := s.service.ListComments(ctx, &todopb.ListCommentsRequest{
+		ProjectId: "3",
+		TaskId:    "1",
+		UserId:    "3",
+		PageSize:  2,
+	})
+	s.NoError(err)
+	s.Len(firstPage.Comments, 2)
+	s.Equal("first", firstPage.Comments[0].Text)
+	s.NotEmpty(firstPage.NextPageToken)
+
+	secondPage, err := s.service.ListComments(ctx, &todopb.ListCommentsRequest{
+		ProjectId: "3",
+		TaskId:    "1",
+		UserId:    "3",
+		PageSize:  2,
+		PageToken: firstPage.NextPageToken,
+	})
+	s.NoError(err)
+	s.Len(secondPage.Comments, 1)
+	s.Equal("third", secondPage.Comments[0].Text)
+	s.Empty(secondPage.NextPageToken)
+
+	fullPage, err := s.service.ListComments(ctx, &todopb.ListCommentsRequest{
+		ProjectId: "3",
+		TaskId:    "1",
+		UserId:    "3",
+		PageSize:  3,
+	})
+	s.NoError(err)
+	s.Len(fullPage.Comments, 3)
+	s.Empty(fullPage.NextPageToken)
+
+	_, err = s.service.ListComments(ctx, &todopb.ListCommentsRequest{
+		ProjectId: "3",
+		TaskId:    "unexisting",
+		UserId:    "3",
+	})
+	s.Equal(codes.NotFound, status.Code(err))
+}
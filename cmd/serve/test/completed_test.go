@@ -0,0 +1,162 @@
+package test
+
+import (
+	"context"
+	"time"
+
+	"github.com/sladonia/todo-sv/pkg/todopb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *Suite) TestListCompletedTasks() {
+	ctx := context.Background()
+
+	finish := &todopb.UpdateTaskRequest{
+		TaskId:     "1",
+		ProjectId:  "3",
+		UserId:     "3",
+		IsFinished: true,
+		FieldMask:  &fieldmaskpb.FieldMask{Paths: []string{todopb.UpdateTaskIsFinishedField}},
+	}
+
+	_, err := s.service.UpdateTask(ctx, finish)
+	s.NoError(err)
+
+	p, err := s.storage.ByID(ctx, "3")
+	s.NoError(err)
+	s.Equal("3", p.Tasks["1"].FinishedBy)
+	s.NotNil(p.Tasks["1"].FinishedAt)
+
+	now := time.Now()
+
+	testCases := []struct {
+		name     string
+		request  *todopb.ListCompletedTasksRequest
+		errCode  codes.Code
+		expected int
+	}{
+		{
+			name: "all_projects",
+			request: &todopb.ListCompletedTasksRequest{
+				UserId: "3",
+				From:   timestamppb.New(now.Add(-time.Hour)),
+				To:     timestamppb.New(now.Add(time.Hour)),
+			},
+			errCode:  codes.OK,
+			expected: 1,
+		},
+		{
+			name: "out_of_range",
+			request: &todopb.ListCompletedTasksRequest{
+				UserId: "3",
+				From:   timestamppb.New(now.Add(-2 * time.Hour)),
+				To:     timestamppb.New(now.Add(-time.Hour)),
+			},
+			errCode:  codes.OK,
+			expected: 0,
+		},
+		{
+			name: "other_project",
+			request: &todopb.ListCompletedTasksRequest{
+				UserId:    "3",
+				ProjectId: "2",
+				From:      timestamppb.New(now.Add(-time.Hour)),
+				To:        timestamppb.New(now.Add(time.Hour)),
+			},
+			errCode:  codes.OK,
+			expected: 0,
+		},
+		{
+			name: "permission_denied",
+			request: &todopb.ListCompletedTasksRequest{
+				UserId:    "1",
+				ProjectId: "3",
+				From:      timestamppb.New(now.Add(-time.Hour)),
+				To:        timestamppb.New(now.Add(time.Hour)),
+			},
+			errCode: codes.PermissionDenied,
+		},
+		{
+			name: "invalid_range",
+			request: &todopb.ListCompletedTasksRequest{
+				UserId: "3",
+				From:   timestamppb.New(now.Add(time.Hour)),
+				To:     timestamppb.New(now.Add(-time.Hour)),
+			},
+			errCode: codes.InvalidArgument,
+		},
+	}
+
+	for _, testCase := range testCases {
+		s.Run(testCase.name, func() {
+			res, err := s.service.ListCompletedTasks(ctx, testCase.request)
+
+			st, ok := status.FromError(err)
+			if !ok {
+				s.FailNow("not a grpc error")
+			}
+
+			s.Equal(testCase.errCode, st.Code())
+
+			if err == nil {
+				s.Len(res.Tasks, testCase.expected)
+			}
+		})
+	}
+
+	finish.IsFinished = false
+
+	_, err = s.service.UpdateTask(ctx, finish)
+	s.NoError(err)
+
+	p, err = s.storage.ByID(ctx, "3")
+	s.NoError(err)
+	s.Empty(p.Tasks["1"].FinishedBy)
+	s.Nil(p.Tasks["1"].FinishedAt)
+}
+
+func (s *Suite) TestListCompletedTasksOrder() {
+	ctx := context.Background()
+
+	now := time.Now().Truncate(time.Millisecond)
+
+	err := s.storage.Insert(ctx, &todopb.Project{
+		Id:      "legacy",
+		Name:    "legacy",
+		OwnerId: "7",
+		Tasks: map[string]*todopb.Task{
+			"1": {
+				Id:         "1",
+				Title:      "finished before finished_at was tracked",
+				IsFinished: true,
+				CreatedAt:  timestamppb.New(now.Add(-time.Hour)),
+				UpdatedAt:  timestamppb.New(now.Add(-10 * time.Minute)),
+			},
+			"2": {
+				Id:         "2",
+				Title:      "finished",
+				IsFinished: true,
+				CreatedAt:  timestamppb.New(now.Add(-time.Hour)),
+				UpdatedAt:  timestamppb.New(now.Add(-20 * time.Minute)),
+				FinishedAt: timestamppb.New(now.Add(-20 * time.Minute)),
+			},
+		},
+		CreatedAt: timestamppb.New(now.Add(-time.Hour)),
+		UpdatedAt: timestamppb.New(now),
+		Version:   "1",
+	})
+	s.Require().NoError(err)
+
+	res, err := s.service.ListCompletedTasks(ctx, &todopb.ListCompletedTasksRequest{
+		UserId: "7",
+		From:   timestamppb.New(now.Add(-time.Hour)),
+		To:     timestamppb.New(now),
+	})
+	s.Require().NoError(err)
+	s.Require().Len(res.Tasks, 2)
+	s.Equal("2", res.Tasks[0].Task.Id)
+	s.Equal("1", res.Tasks[1].Task.Id)
+}
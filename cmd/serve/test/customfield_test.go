@@ -0,0 +1,172 @@
+package test
+
+import (
+	"context"
+
+	"github.com/sladonia/todo-sv/pkg/todopb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+func (s *Suite) defineCustomFields(ctx context.Context) {
+	_, err := s.service.UpdateProject(ctx, &todopb.UpdateProjectRequest{
+		ProjectId: "3",
+		UserId:    "2",
+		CustomFields: []*todopb.CustomFieldDefinition{
+			{Key: "severity", Type: todopb.CustomFieldType_CUSTOM_FIELD_ENUM, Options: []string{"low", "high"}},
+			{Key: "amount", Type: todopb.CustomFieldType_CUSTOM_FIELD_NUMBER},
+			{Key: "assignee", Type: todopb.CustomFieldType_CUSTOM_FIELD_USER},
+		},
+		FieldMask: &fieldmaskpb.FieldMask{Paths: []string{todopb.UpdateProjectCustomFieldsField}},
+	})
+	s.NoError(err)
+}
+
+func (s *Suite) TestUpdateTaskCustomFields() {
+	ctx := context.Background()
+
+	s.defineCustomFields(ctx)
+
+	testCases := []struct {
+		name    string
+		request *todopb.UpdateTaskRequest
+		errCode codes.Code
+	}{
+		{
+			name: "success",
+			request: &todopb.UpdateTaskRequest{
+				TaskId:    "1",
+				ProjectId: "3",
+				UserId:    "3",
+				CustomFields: map[string]*todopb.CustomFieldValue{
+					"severity": {Text: "high"},
+					"assignee": {Text: "3"},
+				},
+				FieldMask: &fieldmaskpb.FieldMask{Paths: []string{"custom_fields.severity", "custom_fields.assignee"}},
+			},
+			errCode: codes.OK,
+		},
+		{
+			name: "unknown_option",
+			request: &todopb.UpdateTaskRequest{
+				TaskId:       "1",
+				ProjectId:    "3",
+				UserId:       "3",
+				CustomFields: map[string]*todopb.CustomFieldValue{"severity": {Text: "critical"}},
+				FieldMask:    &fieldmaskpb.FieldMask{Paths: []string{"custom_fields.severity"}},
+			},
+			errCode: codes.InvalidArgument,
+		},
+		{
+			name: "unknown_user",
+			request: &todopb.UpdateTaskRequest{
+				TaskId:       "1",
+				ProjectId:    "3",
+				UserId:       "3",
+				CustomFields: map[string]*todopb.CustomFieldValue{"assignee": {Text: "unexisting"}},
+				FieldMask:    &fieldmaskpb.FieldMask{Paths: []string{"custom_fields.assignee"}},
+			},
+			errCode: codes.InvalidArgument,
+		},
+		{
+			name: "undefined_field",
+			request: &todopb.UpdateTaskRequest{
+				TaskId:       "1",
+				ProjectId:    "3",
+				UserId:       "3",
+				CustomFields: map[string]*todopb.CustomFieldValue{"customer": {Text: "acme"}},
+				FieldMask:    &fieldmaskpb.FieldMask{Paths: []string{"custom_fields.customer"}},
+			},
+			errCode: codes.InvalidArgument,
+		},
+	}
+
+	for _, testCase := range testCases {
+		s.Run(testCase.name, func() {
+			_, err := s.service.UpdateTask(ctx, testCase.request)
+
+			st, ok := status.FromError(err)
+			if !ok {
+				s.FailNow("not a grpc error")
+			}
+
+			s.Equal(testCase.errCode, st.Code())
+		})
+	}
+
+	p, err := s.storage.ByID(ctx, "3")
+	s.NoError(err)
+	s.Len(p.Tasks["1"].CustomFields, 2)
+	s.Equal("high", p.Tasks["1"].CustomFields["severity"].Text)
+
+	_, err = s.service.UpdateTask(ctx, &todopb.UpdateTaskRequest{
+		TaskId:    "1",
+		ProjectId: "3",
+		UserId:    "3",
+		FieldMask: &fieldmaskpb.FieldMask{Paths: []string{"custom_fields.assignee"}},
+	})
+	s.NoError(err)
+
+	p, err = s.storage.ByID(ctx, "3")
+	s.NoError(err)
+	s.Len(p.Tasks["1"].CustomFields, 1)
+}
+
+func (s *Suite) TestListTasksByCustomFields() {
+	ctx := context.Background()
+
+	s.defineCustomFields(ctx)
+
+	_, err := s.service.AddTask(ctx, &todopb.AddTaskRequest{
+		Title:        "pay rent",
+		ProjectId:    "3",
+		UserId:       "3",
+		CustomFields: map[string]*todopb.CustomFieldValue{"severity": {Text: "high"}, "amount": {Number: 900}},
+	})
+	s.NoError(err)
+
+	res, err := s.service.ListTasks(ctx, &todopb.ListTasksRequest{ProjectId: "3", UserId: "3"})
+	s.NoError(err)
+	s.Len(res.Tasks, 2)
+
+	res, err = s.service.ListTasks(ctx, &todopb.ListTasksRequest{
+		ProjectId:    "3",
+		UserId:       "3",
+		CustomFields: map[string]*todopb.CustomFieldValue{"severity": {Text: "high"}},
+	})
+	s.NoError(err)
+	s.Len(res.Tasks, 1)
+	s.Equal("pay rent", res.Tasks[0].Title)
+
+	_, err = s.service.UpdateProject(ctx, &todopb.UpdateProjectRequest{
+		ProjectId: "3",
+		UserId:    "2",
+		CustomFields: []*todopb.CustomFieldDefinition{
+			{Key: "severity", Type: todopb.CustomFieldType_CUSTOM_FIELD_ENUM},
+		},
+		FieldMask: &fieldmaskpb.FieldMask{Paths: []string{todopb.UpdateProjectCustomFieldsField}},
+	})
+	s.Equal(codes.InvalidArgument, status.Code(err))
+}
+
+func (s *Suite) TestUpdateProjectWithoutFieldMask() {
+	ctx := context.Background()
+
+	s.defineCustomFields(ctx)
+
+	_, err := s.service.UpdateProject(ctx, &todopb.UpdateProjectRequest{
+		ProjectId:    "3",
+		UserId:       "2",
+		Name:         "renamed",
+		Participants: []string{"3"},
+	})
+	s.Require().NoError(err)
+
+	p, err := s.storage.ByID(ctx, "3")
+	s.NoError(err)
+	s.Equal("renamed", p.Name)
+	s.Equal([]string{"3"}, p.Participants)
+	s.Len(p.CustomFields, 3)
+	s.Contains(p.Tasks, "1")
+}
@@ -0,0 +1,182 @@
+package test
+
+import (
+	"context"
+
+	"github.com/sladonia/todo-sv/pkg/todopb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+func (s *Suite) TestAddTaskBlocker() {
+	ctx := context.Background()
+
+	_, err := s.service.AddTask(ctx, &todopb.AddTaskRequest{
+		Title:     "buy stamps",
+		ProjectId: "2",
+		UserId:    "3",
+	})
+	s.NoError(err)
+
+	p, err := s.storage.ByID(ctx, "2")
+	s.NoError(err)
+	s.Len(p.Tasks, 1)
+
+	var stampsTaskID string
+	for id := range p.Tasks {
+		stampsTaskID = id
+	}
+
+	testCases := []struct {
+		name    string
+		request *todopb.TaskBlockerRequest
+		errCode codes.Code
+	}{
+		{
+			name: "success",
+			request: &todopb.TaskBlockerRequest{
+				ProjectId: "3",
+				TaskId:    "1",
+				UserId:    "3",
+				Blocker:   todopb.NewTaskRef("2", stampsTaskID),
+			},
+			errCode: codes.OK,
+		},
+		{
+			name: "cycle",
+			request: &todopb.TaskBlockerRequest{
+				ProjectId: "2",
+				TaskId:    stampsTaskID,
+				UserId:    "3",
+				Blocker:   todopb.NewTaskRef("3", "1"),
+			},
+			errCode: codes.FailedPrecondition,
+		},
+		{
+			name: "self_cycle",
+			request: &todopb.TaskBlockerRequest{
+				ProjectId: "3",
+				TaskId:    "1",
+				UserId:    "3",
+				Blocker:   todopb.NewTaskRef("3", "1"),
+			},
+			errCode: codes.FailedPrecondition,
+		},
+		{
+			name: "blocker_not_found",
+			request: &todopb.TaskBlockerRequest{
+				ProjectId: "3",
+				TaskId:    "1",
+				UserId:    "3",
+				Blocker:   todopb.NewTaskRef("2", "unexisting"),
+			},
+			errCode: codes.NotFound,
+		},
+		{
+			name: "blocker_permission_denied",
+			request: &todopb.TaskBlockerRequest{
+				ProjectId: "2",
+				TaskId:    stampsTaskID,
+				UserId:    "1",
+				Blocker:   todopb.NewTaskRef("3", "1"),
+			},
+			errCode: codes.PermissionDenied,
+		},
+		{
+			name: "invalid_request",
+			request: &todopb.TaskBlockerRequest{
+				ProjectId: "3",
+				TaskId:    "1",
+				UserId:    "3",
+			},
+			errCode: codes.InvalidArgument,
+		},
+	}
+
+	for _, testCase := range testCases {
+		s.Run(testCase.name, func() {
+			_, err := s.service.AddTaskBlocker(ctx, testCase.request)
+
+			st, ok := status.FromError(err)
+			if !ok {
+				s.FailNow("not a grpc error")
+			}
+
+			s.Equal(testCase.errCode, st.Code())
+		})
+	}
+
+	blocked, err := s.service.GetProject(ctx, &todopb.GetProjectRequest{UserId: "3", ProjectId: "3"})
+	s.NoError(err)
+	s.True(blocked.Tasks["1"].IsBlocked)
+	s.Len(blocked.Tasks["1"].BlockedBy, 1)
+
+	finish := &todopb.UpdateTaskRequest{
+		TaskId:     "1",
+		ProjectId:  "3",
+		UserId:     "3",
+		IsFinished: true,
+		FieldMask:  &fieldmaskpb.FieldMask{Paths: []string{todopb.UpdateTaskIsFinishedField}},
+	}
+
+	_, err = s.service.UpdateTask(ctx, finish)
+	s.Equal(codes.FailedPrecondition, status.Code(err))
+
+	_, err = s.service.UpdateTask(ctx, &todopb.UpdateTaskRequest{
+		TaskId:     stampsTaskID,
+		ProjectId:  "2",
+		UserId:     "3",
+		IsFinished: true,
+		FieldMask:  &fieldmaskpb.FieldMask{Paths: []string{todopb.UpdateTaskIsFinishedField}},
+	})
+	s.NoError(err)
+
+	unblocked, err := s.service.GetProject(ctx, &todopb.GetProjectRequest{UserId: "3", ProjectId: "3"})
+	s.NoError(err)
+	s.False(unblocked.Tasks["1"].IsBlocked)
+
+	_, err = s.service.UpdateTask(ctx, finish)
+	s.NoError(err)
+}
+
+func (s *Suite) TestRemoveTaskBlocker() {
+	ctx := context.Background()
+
+	_, err := s.service.AddTask(ctx, &todopb.AddTaskRequest{
+		Title:     "find the bill",
+		ProjectId: "3",
+		UserId:    "3",
+	})
+	s.NoError(err)
+
+	p, err := s.storage.ByID(ctx, "3")
+	s.NoError(err)
+
+	var blockerID string
+	for id := range p.Tasks {
+		if id != "1" {
+			blockerID = id
+		}
+	}
+
+	request := &todopb.TaskBlockerRequest{
+		ProjectId: "3",
+		TaskId:    "1",
+		UserId:    "3",
+		Blocker:   todopb.NewTaskRef("3", blockerID),
+	}
+
+	_, err = s.service.AddTaskBlocker(ctx, request)
+	s.NoError(err)
+
+	_, err = s.service.RemoveTaskBlocker(ctx, request)
+	s.NoError(err)
+
+	stored, err := s.storage.ByID(ctx, "3")
+	s.NoError(err)
+	s.Empty(stored.Tasks["1"].BlockedBy)
+
+	_, err = s.service.RemoveTaskBlocker(ctx, request)
+	s.Equal(codes.NotFound, status.Code(err))
+}
@@ -0,0 +1,179 @@
+package test
+
+import (
+	"context"
+	"time"
+
+	"github.com/sladonia/todo-sv/pkg/todopb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *Suite) TestInvitations() {
+	ctx := context.Background()
+
+	subscribeServer := newMockSubscribeServer()
+
+	go func() {
+		err := s.service.SubscribeToProjectsUpdates(
+			&todopb.ProjectsUpdatesRequest{UserId: "8", DeviceId: "1"},
+			subscribeServer,
+		)
+		s.Require().NoError(err)
+	}()
+
+	time.Sleep(50 * time.Millisecond)
+
+	_, err := s.service.InviteToProject(ctx, &todopb.InviteToProjectRequest{
+		ProjectId: "2",
+		UserId:    "2",
+		InviteeId: "8",
+	})
+	s.Equal(codes.PermissionDenied, status.Code(err))
+
+	_, err = s.service.InviteToProject(ctx, &todopb.InviteToProjectRequest{
+		ProjectId: "2",
+		UserId:    "1",
+		InviteeId: "3",
+	})
+	s.Equal(codes.FailedPrecondition, status.Code(err))
+
+	_, err = s.service.SetMemberRole(ctx, &todopb.SetMemberRoleRequest{
+		ProjectId: "2",
+		UserId:    "1",
+		MemberId:  "8",
+		Role:      todopb.Role_ROLE_VIEWER,
+	})
+	s.Equal(codes.FailedPrecondition, status.Code(err))
+
+	invitation, err := s.service.InviteToProject(ctx, &todopb.InviteToProjectRequest{
+		ProjectId: "2",
+		UserId:    "1",
+		InviteeId: "8",
+		Role:      todopb.Role_ROLE_VIEWER,
+	})
+	s.Require().NoError(err)
+	s.Equal(todopb.InvitationStatus_INVITATION_PENDING, invitation.Status)
+	s.Equal("to-buy", invitation.ProjectName)
+
+	ev := <-subscribeServer.eventCh
+	s.Equal(todopb.EventType_INVITATION_RECEIVED, ev.Type)
+	s.Equal(invitation.Id, ev.Invitation.Id)
+
+	repeated, err := s.service.InviteToProject(ctx, &todopb.InviteToProjectRequest{
+		ProjectId: "2",
+		UserId:    "1",
+		InviteeId: "8",
+		Role:      todopb.Role_ROLE_VIEWER,
+	})
+	s.NoError(err)
+	s.Equal(invitation.Id, repeated.Id)
+
+	list, err := s.service.ListInvitations(ctx, &todopb.ListInvitationsRequest{UserId: "8"})
+	s.NoError(err)
+	s.Require().Len(list.Invitations, 1)
+
+	_, err = s.service.GetProject(ctx, &todopb.GetProjectRequest{ProjectId: "2", UserId: "8"})
+	s.Equal(codes.PermissionDenied, status.Code(err))
+
+	_, err = s.service.AcceptInvitation(ctx, &todopb.InvitationResponseRequest{
+		InvitationId: invitation.Id,
+		UserId:       "9",
+	})
+	s.Equal(codes.PermissionDenied, status.Code(err))
+
+	_, err = s.service.AcceptInvitation(ctx, &todopb.InvitationResponseRequest{
+		InvitationId: invitation.Id,
+		UserId:       "8",
+	})
+	s.NoError(err)
+
+	p, err := s.service.GetProject(ctx, &todopb.GetProjectRequest{ProjectId: "2", UserId: "8"})
+	s.NoError(err)
+	s.Contains(p.Participants, "8")
+	s.Equal(todopb.Role_ROLE_VIEWER, p.Roles["8"])
+
+	_, err = s.service.DeclineInvitation(ctx, &todopb.InvitationResponseRequest{
+		InvitationId: invitation.Id,
+		UserId:       "8",
+	})
+	s.Equal(codes.FailedPrecondition, status.Code(err))
+
+	list, err = s.service.ListInvitations(ctx, &todopb.ListInvitationsRequest{UserId: "8"})
+	s.NoError(err)
+	s.Empty(list.Invitations)
+}
+
+func (s *Suite) TestDeclineInvitation() {
+	ctx := context.Background()
+
+	project, err := s.service.CreateProject(ctx, &todopb.CreateProjectRequest{
+		Name:         "trip",
+		OwnerId:      "1",
+		Participants: []string{"9"},
+	})
+	s.Require().NoError(err)
+	s.Empty(project.Participants)
+
+	list, err := s.service.ListInvitations(ctx, &todopb.ListInvitationsRequest{UserId: "9"})
+	s.NoError(err)
+	s.Require().Len(list.Invitations, 1)
+	s.Equal(project.Id, list.Invitations[0].ProjectId)
+	s.Equal(todopb.Role_ROLE_EDITOR, list.Invitations[0].Role)
+
+	_, err = s.service.DeclineInvitation(ctx, &todopb.InvitationResponseRequest{
+		InvitationId: list.Invitations[0].Id,
+		UserId:       "9",
+	})
+	s.NoError(err)
+
+	all, err := s.service.AllProjects(ctx, &todopb.AllProjectsRequest{UserId: "9"})
+	s.NoError(err)
+	s.Empty(all.Projects)
+}
+
+func (s *Suite) TestExpiredInvitation() {
+	ctx := context.Background()
+
+	p, err := s.storage.ByID(ctx, "2")
+	s.Require().NoError(err)
+
+	invitation := todopb.NewInvitation(p, "1", "8", todopb.Role_ROLE_EDITOR, time.Hour)
+	invitation.ExpiresAt = timestamppb.New(time.Now().Add(-time.Minute))
+
+	err = s.invitationStorage.Insert(ctx, invitation)
+	s.Require().NoError(err)
+
+	list, err := s.service.ListInvitations(ctx, &todopb.ListInvitationsRequest{UserId: "8"})
+	s.NoError(err)
+	s.Empty(list.Invitations)
+
+	_, err = s.service.AcceptInvitation(ctx, &todopb.InvitationResponseRequest{
+		InvitationId: invitation.Id,
+		UserId:       "8",
+	})
+	s.Equal(codes.FailedPrecondition, status.Code(err))
+
+	renewed, err := s.service.InviteToProject(ctx, &todopb.InviteToProjectRequest{
+		ProjectId: "2",
+		UserId:    "1",
+		InviteeId: "8",
+	})
+	s.NoError(err)
+	s.NotEqual(invitation.Id, renewed.Id)
+}
+
+// acceptInvitations accepts all the pending invitations of the user.
+func (s *Suite) acceptInvitations(ctx context.Context, userID string) {
+	list, err := s.service.ListInvitations(ctx, &todopb.ListInvitationsRequest{UserId: userID})
+	s.Require().NoError(err)
+
+	for _, invitation := range list.Invitations {
+		_, err = s.service.AcceptInvitation(ctx, &todopb.InvitationResponseRequest{
+			InvitationId: invitation.Id,
+			UserId:       userID,
+		})
+		s.Require().NoError(err)
+	}
+}
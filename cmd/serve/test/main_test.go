@@ -9,6 +9,7 @@ import (
 	"github.com/ory/dockertest/v3"
 	"github.com/sladonia/dockert"
 	"github.com/sladonia/dockert/container"
+	"github.com/sladonia/todo-sv/internal/authz"
 	"github.com/sladonia/todo-sv/internal/logger"
 	"github.com/sladonia/todo-sv/internal/mongodb"
 	"github.com/sladonia/todo-sv/internal/todo"
@@ -21,8 +22,18 @@ import (
 )
 
 const (
-	projectDBName          = "todo_test"
-	projectsCollectionName = "projects_test"
+	projectDBName                 = "todo_test"
+	projectsCollectionName        = "projects_test"
+	commentsCollectionName        = "comments_test"
+	templatesCollectionName       = "templates_test"
+	tagsCollectionName            = "tags_test"
+	timeEntriesCollectionName     = "time_entries_test"
+	preferencesCollectionName     = "preferences_test"
+	serviceAccountsCollectionName = "service_accounts_test"
+	invitationsCollectionName     = "invitations_test"
+	shareLinksCollectionName      = "share_links_test"
+	teamsCollectionName           = "teams_test"
+	maxAttachmentSize             = 1024
 )
 
 var projectFixtureInserted1 = &todopb.Project{
@@ -62,15 +73,25 @@ var projectFixtureInserted2 = &todopb.Project{
 type Suite struct {
 	suite.Suite
 
-	log               *zap.Logger
-	dockerPool        *dockertest.Pool
-	db                *mongo.Database
-	containerRegistry *dockert.Registry
-	mongoDSN          string
-	natsDSN           string
-	storage           todo.Storage
-	pubSub            todo.PubSub
-	service           todopb.ToDoServiceServer
+	log                   *zap.Logger
+	dockerPool            *dockertest.Pool
+	db                    *mongo.Database
+	containerRegistry     *dockert.Registry
+	mongoDSN              string
+	natsDSN               string
+	storage               todo.Storage
+	commentStorage        todo.CommentStorage
+	templateStorage       todo.TemplateStorage
+	tagStorage            todo.TagStorage
+	timeEntryStorage      todo.TimeEntryStorage
+	preferencesStorage    todo.PreferencesStorage
+	serviceAccountStorage todo.ServiceAccountStorage
+	invitationStorage     todo.InvitationStorage
+	shareLinkStorage      todo.ShareLinkStorage
+	teamStorage           todo.TeamStorage
+	blobStore             todo.BlobStore
+	pubSub                todo.PubSub
+	service               todopb.ToDoServiceServer
 }
 
 func (s *Suite) SetupSuite() {
@@ -113,6 +134,18 @@ func (s *Suite) SetupSuite() {
 	}
 
 	s.storage = todo.NewStorage(s.db, projectsCollectionName)
+	s.commentStorage = todo.NewCommentStorage(s.db, commentsCollectionName)
+	s.templateStorage = todo.NewTemplateStorage(s.db, templatesCollectionName)
+	s.tagStorage = todo.NewTagStorage(s.db, tagsCollectionName)
+	s.timeEntryStorage, err = todo.NewTimeEntryStorage(ctx, s.db, timeEntriesCollectionName)
+	if err != nil {
+		s.log.Panic("failed to create time entry storage", zap.Error(err))
+	}
+	s.preferencesStorage = todo.NewPreferencesStorage(s.db, preferencesCollectionName)
+	s.serviceAccountStorage = todo.NewServiceAccountStorage(s.db, serviceAccountsCollectionName)
+	s.invitationStorage = todo.NewInvitationStorage(s.db, invitationsCollectionName)
+	s.shareLinkStorage = todo.NewShareLinkStorage(s.db, shareLinksCollectionName)
+	s.teamStorage = todo.NewTeamStorage(s.db, teamsCollectionName)
 
 	s.pubSub, err = todo.NewNatsPubSub(s.natsDSN)
 	if err != nil {
@@ -134,7 +167,49 @@ func (s *Suite) SetupSuite() {
 		}
 	}()
 
-	s.service = todo.NewService(s.log, s.storage, s.pubSub)
+	s.blobStore, err = todo.NewLocalBlobStore(s.T().TempDir())
+	if err != nil {
+		s.log.Panic("create blob store", zap.Error(err))
+	}
+
+	s.service = s.newService(authz.DefaultPolicy)
+}
+
+// newService creates the service authorizing the requests by the policies.
+func (s *Suite) newService(policies ...authz.Policy) todopb.ToDoServiceServer {
+	authorizer, err := authz.NewPolicyAuthorizer(policies...)
+	if err != nil {
+		s.log.Panic("create authorizer", zap.Error(err))
+	}
+
+	return s.newServiceWithAuthorizer(authorizer)
+}
+
+func (s *Suite) newServiceWithAuthorizer(authorizer authz.Authorizer) todopb.ToDoServiceServer {
+	return todo.NewService(
+		s.log,
+		s.storage,
+		s.commentStorage,
+		s.templateStorage,
+		s.tagStorage,
+		s.timeEntryStorage,
+		s.preferencesStorage,
+		s.serviceAccountStorage,
+		s.invitationStorage,
+		s.shareLinkStorage,
+		s.teamStorage,
+		s.blobStore,
+		todo.AttachmentLimits{
+			MaxSize:             maxAttachmentSize,
+			AllowedContentTypes: []string{"text/plain"},
+		},
+		todo.MentionOptions{RejectInaccessible: true},
+		todo.InvitationOptions{TTL: time.Hour},
+		todo.ShareLinkOptions{DefaultTTL: time.Hour, MaxTTL: 24 * time.Hour},
+		todo.Quotas{ProjectsPerOwner: 5, TasksPerProject: 5, ParticipantsPerProject: 3},
+		authorizer,
+		s.pubSub,
+	)
 }
 
 func (s *Suite) TearDownSuite() {
@@ -161,6 +236,51 @@ func (s *Suite) TearDownTest() {
 	if err != nil {
 		s.log.Panic("failed to delete projects", zap.Error(err))
 	}
+
+	_, err = s.db.Collection(commentsCollectionName).DeleteMany(context.Background(), bson.M{})
+	if err != nil {
+		s.log.Panic("failed to delete comments", zap.Error(err))
+	}
+
+	_, err = s.db.Collection(templatesCollectionName).DeleteMany(context.Background(), bson.M{})
+	if err != nil {
+		s.log.Panic("failed to delete templates", zap.Error(err))
+	}
+
+	_, err = s.db.Collection(tagsCollectionName).DeleteMany(context.Background(), bson.M{})
+	if err != nil {
+		s.log.Panic("failed to delete tags", zap.Error(err))
+	}
+
+	_, err = s.db.Collection(timeEntriesCollectionName).DeleteMany(context.Background(), bson.M{})
+	if err != nil {
+		s.log.Panic("failed to delete time entries", zap.Error(err))
+	}
+
+	_, err = s.db.Collection(preferencesCollectionName).DeleteMany(context.Background(), bson.M{})
+	if err != nil {
+		s.log.Panic("failed to delete user preferences", zap.Error(err))
+	}
+
+	_, err = s.db.Collection(serviceAccountsCollectionName).DeleteMany(context.Background(), bson.M{})
+	if err != nil {
+		s.log.Panic("failed to delete service accounts", zap.Error(err))
+	}
+
+	_, err = s.db.Collection(invitationsCollectionName).DeleteMany(context.Background(), bson.M{})
+	if err != nil {
+		s.log.Panic("failed to delete invitations", zap.Error(err))
+	}
+
+	_, err = s.db.Collection(shareLinksCollectionName).DeleteMany(context.Background(), bson.M{})
+	if err != nil {
+		s.log.Panic("failed to delete share links", zap.Error(err))
+	}
+
+	_, err = s.db.Collection(teamsCollectionName).DeleteMany(context.Background(), bson.M{})
+	if err != nil {
+		s.log.Panic("failed to delete teams", zap.Error(err))
+	}
 }
 
 func TestSuite(t *testing.T) {
@@ -0,0 +1,75 @@
+package test
+
+import (
+	"context"
+	"time"
+
+	"github.com/sladonia/todo-sv/pkg/todopb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+func (s *Suite) TestMentionInComment() {
+	ctx := context.Background()
+
+	subscribeServer := newMockSubscribeServer()
+
+	go func() {
+		err := s.service.SubscribeToProjectsUpdates(
+			&todopb.ProjectsUpdatesRequest{UserId: "3", DeviceId: "1"},
+			subscribeServer,
+		)
+		s.Require().NoError(err)
+	}()
+
+	time.Sleep(50 * time.Millisecond)
+
+	comment, err := s.service.AddComment(ctx, &todopb.AddCommentRequest{
+		ProjectId: "3",
+		TaskId:    "1",
+		UserId:    "2",
+		Text:      "@3 could you pay it? ask billing@example.com",
+	})
+	s.NoError(err)
+	s.Equal([]string{"3"}, comment.MentionedUserIds)
+
+	var mention *todopb.Mention
+
+	for i := 0; i < 2; i++ {
+		ev := <-subscribeServer.eventCh
+		if ev.Type == todopb.EventType_USER_MENTIONED {
+			mention = ev.Mention
+		}
+	}
+
+	s.Require().NotNil(mention)
+	s.Equal("2", mention.AuthorId)
+	s.Equal("1", mention.TaskId)
+	s.Equal(comment.Id, mention.CommentId)
+}
+
+func (s *Suite) TestMentionInaccessibleUser() {
+	ctx := context.Background()
+
+	_, err := s.service.AddComment(ctx, &todopb.AddCommentRequest{
+		ProjectId: "3",
+		TaskId:    "1",
+		UserId:    "2",
+		Text:      "@unexisting take a look",
+	})
+	s.Equal(codes.InvalidArgument, status.Code(err))
+
+	_, err = s.service.UpdateTask(ctx, &todopb.UpdateTaskRequest{
+		TaskId:      "1",
+		ProjectId:   "3",
+		UserId:      "3",
+		Description: "@2 has the invoice",
+		FieldMask:   &fieldmaskpb.FieldMask{Paths: []string{todopb.UpdateTaskDescriptionField}},
+	})
+	s.NoError(err)
+
+	p, err := s.storage.ByID(ctx, "3")
+	s.NoError(err)
+	s.Equal([]string{"2"}, p.Tasks["1"].MentionedUserIds)
+}
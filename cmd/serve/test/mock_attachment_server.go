@@ -0,0 +1,64 @@
+package test
+
+import (
+	"context"
+	"io"
+
+	"github.com/sladonia/todo-sv/pkg/todopb"
+	"google.golang.org/grpc"
+)
+
+type mockUploadServer struct {
+	requests []*todopb.UploadAttachmentRequest
+	response *todopb.Attachment
+	ctx      context.Context
+	grpc.ServerStream
+}
+
+func newMockUploadServer(requests ...*todopb.UploadAttachmentRequest) *mockUploadServer {
+	return &mockUploadServer{
+		requests: requests,
+		ctx:      context.Background(),
+	}
+}
+
+func (s *mockUploadServer) Recv() (*todopb.UploadAttachmentRequest, error) {
+	if len(s.requests) == 0 {
+		return nil, io.EOF
+	}
+
+	r := s.requests[0]
+	s.requests = s.requests[1:]
+
+	return r, nil
+}
+
+func (s *mockUploadServer) SendAndClose(a *todopb.Attachment) error {
+	s.response = a
+	return nil
+}
+
+func (s *mockUploadServer) Context() context.Context {
+	return s.ctx
+}
+
+type mockDownloadServer struct {
+	responses []*todopb.DownloadAttachmentResponse
+	ctx       context.Context
+	grpc.ServerStream
+}
+
+func newMockDownloadServer() *mockDownloadServer {
+	return &mockDownloadServer{
+		ctx: context.Background(),
+	}
+}
+
+func (s *mockDownloadServer) Send(r *todopb.DownloadAttachmentResponse) error {
+	s.responses = append(s.responses, r)
+	return nil
+}
+
+func (s *mockDownloadServer) Context() context.Context {
+	return s.ctx
+}
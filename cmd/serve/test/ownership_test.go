@@ -0,0 +1,87 @@
+package test
+
+import (
+	"context"
+
+	"github.com/sladonia/todo-sv/pkg/todopb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *Suite) TestLeaveProject() {
+	ctx := context.Background()
+
+	_, err := s.service.LeaveProject(ctx, &todopb.LeaveProjectRequest{ProjectId: "2", UserId: "1"})
+	s.Equal(codes.FailedPrecondition, status.Code(err))
+
+	_, err = s.service.LeaveProject(ctx, &todopb.LeaveProjectRequest{ProjectId: "2", UserId: "4"})
+	s.Equal(codes.FailedPrecondition, status.Code(err))
+
+	_, err = s.service.LeaveProject(ctx, &todopb.LeaveProjectRequest{ProjectId: "2", UserId: "3"})
+	s.NoError(err)
+
+	p, err := s.storage.ByID(ctx, "2")
+	s.NoError(err)
+	s.Equal([]string{"2"}, p.Participants)
+
+	_, err = s.service.GetProject(ctx, &todopb.GetProjectRequest{ProjectId: "2", UserId: "3"})
+	s.Equal(codes.PermissionDenied, status.Code(err))
+}
+
+func (s *Suite) TestTransferOwnership() {
+	ctx := context.Background()
+
+	_, err := s.service.TransferOwnership(ctx, &todopb.TransferOwnershipRequest{
+		ProjectId:  "2",
+		UserId:     "2",
+		NewOwnerId: "3",
+	})
+	s.Equal(codes.PermissionDenied, status.Code(err))
+
+	_, err = s.service.TransferOwnership(ctx, &todopb.TransferOwnershipRequest{
+		ProjectId:  "2",
+		UserId:     "1",
+		NewOwnerId: "4",
+	})
+	s.Equal(codes.FailedPrecondition, status.Code(err))
+
+	_, err = s.service.AcceptOwnership(ctx, &todopb.OwnershipTransferRequest{ProjectId: "2", UserId: "2"})
+	s.Equal(codes.FailedPrecondition, status.Code(err))
+
+	_, err = s.service.TransferOwnership(ctx, &todopb.TransferOwnershipRequest{
+		ProjectId:  "2",
+		UserId:     "1",
+		NewOwnerId: "2",
+	})
+	s.NoError(err)
+
+	_, err = s.service.AcceptOwnership(ctx, &todopb.OwnershipTransferRequest{ProjectId: "2", UserId: "3"})
+	s.Equal(codes.PermissionDenied, status.Code(err))
+
+	_, err = s.service.DeclineOwnership(ctx, &todopb.OwnershipTransferRequest{ProjectId: "2", UserId: "2"})
+	s.NoError(err)
+
+	p, err := s.storage.ByID(ctx, "2")
+	s.NoError(err)
+	s.Empty(p.PendingOwnerId)
+	s.Equal("1", p.OwnerId)
+
+	_, err = s.service.TransferOwnership(ctx, &todopb.TransferOwnershipRequest{
+		ProjectId:  "2",
+		UserId:     "1",
+		NewOwnerId: "2",
+	})
+	s.NoError(err)
+
+	_, err = s.service.AcceptOwnership(ctx, &todopb.OwnershipTransferRequest{ProjectId: "2", UserId: "2"})
+	s.NoError(err)
+
+	p, err = s.storage.ByID(ctx, "2")
+	s.NoError(err)
+	s.Equal("2", p.OwnerId)
+	s.Empty(p.PendingOwnerId)
+	s.ElementsMatch([]string{"3", "1"}, p.Participants)
+
+	_, err = s.service.LeaveProject(ctx, &todopb.LeaveProjectRequest{ProjectId: "2", UserId: "1"})
+	s.NoError(err)
+}
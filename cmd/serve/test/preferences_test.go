@@ -0,0 +1,73 @@
+package test
+
+import (
+	"context"
+	"time"
+
+	"github.com/sladonia/todo-sv/pkg/todopb"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+func (s *Suite) TestUserPreferences() {
+	ctx := context.Background()
+
+	subscribeServer := newMockSubscribeServer()
+
+	go func() {
+		err := s.service.SubscribeToProjectsUpdates(
+			&todopb.ProjectsUpdatesRequest{UserId: "3", DeviceId: "2"},
+			subscribeServer,
+		)
+		s.Require().NoError(err)
+	}()
+
+	time.Sleep(50 * time.Millisecond)
+
+	prefs, err := s.service.UpdateUserPreferences(ctx, &todopb.UpdateUserPreferencesRequest{
+		UserId:           "3",
+		PinnedProjectIds: []string{"3"},
+		FieldMask:        &fieldmaskpb.FieldMask{Paths: []string{todopb.UpdatePreferencesPinnedField}},
+	})
+	s.NoError(err)
+	s.Equal([]string{"3"}, prefs.PinnedProjectIds)
+
+	ev := <-subscribeServer.eventCh
+	s.Equal(todopb.EventType_PREFERENCES_UPDATED, ev.Type)
+	s.Equal([]string{"3"}, ev.Preferences.PinnedProjectIds)
+
+	all, err := s.service.AllProjects(ctx, &todopb.AllProjectsRequest{UserId: "3"})
+	s.NoError(err)
+	s.Require().Len(all.Projects, 2)
+	s.Equal("3", all.Projects[0].Id)
+	s.True(all.Projects[0].Pinned)
+	s.False(all.Projects[1].Pinned)
+
+	_, err = s.service.UpdateUserPreferences(ctx, &todopb.UpdateUserPreferencesRequest{
+		UserId:           "3",
+		ProjectOrder:     []string{"3", "2"},
+		HiddenProjectIds: []string{"3"},
+		FieldMask: &fieldmaskpb.FieldMask{Paths: []string{
+			todopb.UpdatePreferencesOrderField,
+			todopb.UpdatePreferencesHiddenField,
+		}},
+	})
+	s.NoError(err)
+
+	all, err = s.service.AllProjects(ctx, &todopb.AllProjectsRequest{UserId: "3"})
+	s.NoError(err)
+	s.Require().Len(all.Projects, 1)
+	s.Equal("2", all.Projects[0].Id)
+
+	all, err = s.service.AllProjects(ctx, &todopb.AllProjectsRequest{UserId: "3", IncludeHidden: true})
+	s.NoError(err)
+	s.Len(all.Projects, 2)
+
+	prefs, err = s.service.GetUserPreferences(ctx, &todopb.GetUserPreferencesRequest{UserId: "3"})
+	s.NoError(err)
+	s.Equal([]string{"3"}, prefs.PinnedProjectIds)
+	s.Equal([]string{"3", "2"}, prefs.ProjectOrder)
+
+	prefs, err = s.service.GetUserPreferences(ctx, &todopb.GetUserPreferencesRequest{UserId: "1"})
+	s.NoError(err)
+	s.Empty(prefs.PinnedProjectIds)
+}
@@ -0,0 +1,69 @@
+package test
+
+import (
+	"context"
+
+	"github.com/sladonia/todo-sv/pkg/todopb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+func (s *Suite) TestGetProjectProgress() {
+	ctx := context.Background()
+
+	_, err := s.service.AddTask(ctx, &todopb.AddTaskRequest{
+		Title:     "read the meter",
+		ProjectId: "3",
+		UserId:    "3",
+		Estimate:  &todopb.Estimate{Points: 3, Minutes: 20},
+	})
+	s.NoError(err)
+
+	_, err = s.service.UpdateTask(ctx, &todopb.UpdateTaskRequest{
+		TaskId:     "1",
+		ProjectId:  "3",
+		UserId:     "3",
+		IsFinished: true,
+		Estimate:   &todopb.Estimate{Points: 2},
+		FieldMask: &fieldmaskpb.FieldMask{Paths: []string{
+			todopb.UpdateTaskIsFinishedField,
+			todopb.UpdateTaskEstimateField,
+		}},
+	})
+	s.NoError(err)
+
+	p, err := s.storage.ByID(ctx, "3")
+	s.NoError(err)
+	s.NotNil(p.Tasks["1"].FinishedAt)
+
+	progress, err := s.service.GetProjectProgress(ctx, &todopb.GetProjectProgressRequest{
+		ProjectId: "3",
+		UserId:    "3",
+		Days:      3,
+	})
+	s.NoError(err)
+	s.Equal(uint32(2), progress.Total.Tasks)
+	s.Equal(uint32(5), progress.Total.Points)
+	s.Equal(uint32(20), progress.Total.Minutes)
+	s.Equal(uint32(1), progress.Finished.Tasks)
+	s.Equal(uint32(2), progress.Finished.Points)
+	s.Len(progress.Burndown, 3)
+
+	today := progress.Burndown[2].Remaining
+	s.Equal(uint32(1), today.Tasks)
+	s.Equal(uint32(3), today.Points)
+
+	_, err = s.service.GetProjectProgress(ctx, &todopb.GetProjectProgressRequest{
+		ProjectId: "3",
+		UserId:    "unexisting",
+	})
+	s.Equal(codes.PermissionDenied, status.Code(err))
+
+	_, err = s.service.GetProjectProgress(ctx, &todopb.GetProjectProgressRequest{
+		ProjectId: "3",
+		UserId:    "3",
+		Days:      1000,
+	})
+	s.Equal(codes.InvalidArgument, status.Code(err))
+}
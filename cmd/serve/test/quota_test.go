@@ -0,0 +1,90 @@
+package test
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/sladonia/todo-sv/pkg/todopb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *Suite) TestProjectQuota() {
+	ctx := context.Background()
+
+	for i := 0; i < 5; i++ {
+		_, err := s.service.CreateProject(ctx, &todopb.CreateProjectRequest{
+			Name:    fmt.Sprintf("project %d", i),
+			OwnerId: "10",
+		})
+		s.Require().NoError(err)
+	}
+
+	_, err := s.service.CreateProject(ctx, &todopb.CreateProjectRequest{Name: "one too many", OwnerId: "10"})
+	s.Equal(codes.ResourceExhausted, status.Code(err))
+
+	all, err := s.service.AllProjects(ctx, &todopb.AllProjectsRequest{UserId: "10"})
+	s.NoError(err)
+	s.Len(all.Projects, 5)
+}
+
+func (s *Suite) TestTaskQuota() {
+	ctx := context.Background()
+
+	for i := 0; i < 4; i++ {
+		_, err := s.service.AddTask(ctx, &todopb.AddTaskRequest{
+			ProjectId: "3",
+			UserId:    "2",
+			Title:     fmt.Sprintf("task %d", i),
+		})
+		s.Require().NoError(err)
+	}
+
+	_, err := s.service.AddTask(ctx, &todopb.AddTaskRequest{ProjectId: "3", UserId: "2", Title: "one too many"})
+	s.Equal(codes.ResourceExhausted, status.Code(err))
+
+	p, err := s.storage.ByID(ctx, "3")
+	s.NoError(err)
+	s.Len(p.Tasks, 5)
+}
+
+func (s *Suite) TestParticipantQuota() {
+	ctx := context.Background()
+
+	_, err := s.service.InviteToProject(ctx, &todopb.InviteToProjectRequest{
+		ProjectId: "2",
+		UserId:    "1",
+		InviteeId: "4",
+	})
+	s.Require().NoError(err)
+
+	_, err = s.service.InviteToProject(ctx, &todopb.InviteToProjectRequest{
+		ProjectId: "2",
+		UserId:    "1",
+		InviteeId: "5",
+	})
+	s.Require().NoError(err)
+
+	s.acceptInvitations(ctx, "4")
+
+	_, err = s.service.InviteToProject(ctx, &todopb.InviteToProjectRequest{
+		ProjectId: "2",
+		UserId:    "1",
+		InviteeId: "6",
+	})
+	s.Equal(codes.ResourceExhausted, status.Code(err))
+
+	list, err := s.service.ListInvitations(ctx, &todopb.ListInvitationsRequest{UserId: "5"})
+	s.NoError(err)
+	s.Require().Len(list.Invitations, 1)
+
+	_, err = s.service.AcceptInvitation(ctx, &todopb.InvitationResponseRequest{
+		InvitationId: list.Invitations[0].Id,
+		UserId:       "5",
+	})
+	s.Equal(codes.ResourceExhausted, status.Code(err))
+
+	p, err := s.storage.ByID(ctx, "2")
+	s.NoError(err)
+	s.ElementsMatch([]string{"2", "3", "4"}, p.Participants)
+}
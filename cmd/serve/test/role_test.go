@@ -0,0 +1,152 @@
+package test
+
+import (
+	"context"
+
+	"github.com/sladonia/todo-sv/pkg/todopb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+func (s *Suite) TestMemberRoles() {
+	ctx := context.Background()
+
+	p, err := s.storage.ByID(ctx, "3")
+	s.NoError(err)
+
+	role, ok := p.RoleOf("3")
+	s.True(ok)
+	s.Equal(todopb.Role_ROLE_EDITOR, role)
+
+	_, err = s.service.SetMemberRole(ctx, &todopb.SetMemberRoleRequest{
+		ProjectId: "3",
+		UserId:    "3",
+		MemberId:  "4",
+		Role:      todopb.Role_ROLE_VIEWER,
+	})
+	s.Equal(codes.PermissionDenied, status.Code(err))
+
+	invitation, err := s.service.InviteToProject(ctx, &todopb.InviteToProjectRequest{
+		ProjectId: "3",
+		UserId:    "2",
+		InviteeId: "4",
+		Role:      todopb.Role_ROLE_VIEWER,
+	})
+	s.Require().NoError(err)
+
+	_, err = s.service.AcceptInvitation(ctx, &todopb.InvitationResponseRequest{
+		InvitationId: invitation.Id,
+		UserId:       "4",
+	})
+	s.NoError(err)
+
+	_, err = s.service.GetProject(ctx, &todopb.GetProjectRequest{ProjectId: "3", UserId: "4"})
+	s.NoError(err)
+
+	_, err = s.service.ListComments(ctx, &todopb.ListCommentsRequest{ProjectId: "3", TaskId: "1", UserId: "4"})
+	s.NoError(err)
+
+	_, err = s.service.AddTask(ctx, &todopb.AddTaskRequest{ProjectId: "3", UserId: "4", Title: "rent"})
+	s.Equal(codes.PermissionDenied, status.Code(err))
+
+	_, err = s.service.AddComment(ctx, &todopb.AddCommentRequest{
+		ProjectId: "3",
+		TaskId:    "1",
+		UserId:    "4",
+		Text:      "paid?",
+	})
+	s.Equal(codes.PermissionDenied, status.Code(err))
+
+	_, err = s.service.SetMemberRole(ctx, &todopb.SetMemberRoleRequest{
+		ProjectId: "3",
+		UserId:    "2",
+		MemberId:  "3",
+		Role:      todopb.Role_ROLE_ADMIN,
+	})
+	s.NoError(err)
+
+	_, err = s.service.UpdateProject(ctx, &todopb.UpdateProjectRequest{
+		ProjectId: "3",
+		UserId:    "3",
+		Name:      "bills",
+		FieldMask: &fieldmaskpb.FieldMask{Paths: []string{todopb.UpdateProjectNameField}},
+	})
+	s.NoError(err)
+
+	_, err = s.service.UpdateProject(ctx, &todopb.UpdateProjectRequest{
+		ProjectId: "3",
+		UserId:    "3",
+		OwnerId:   "3",
+		FieldMask: &fieldmaskpb.FieldMask{Paths: []string{todopb.UpdateProjectOwnerIDField}},
+	})
+	s.Equal(codes.PermissionDenied, status.Code(err))
+
+	_, err = s.service.SetMemberRole(ctx, &todopb.SetMemberRoleRequest{
+		ProjectId: "3",
+		UserId:    "3",
+		MemberId:  "4",
+		Role:      todopb.Role_ROLE_ADMIN,
+	})
+	s.Equal(codes.PermissionDenied, status.Code(err))
+
+	_, err = s.service.SetMemberRole(ctx, &todopb.SetMemberRoleRequest{
+		ProjectId: "3",
+		UserId:    "2",
+		MemberId:  "4",
+		Role:      todopb.Role_ROLE_OWNER,
+	})
+	s.Equal(codes.InvalidArgument, status.Code(err))
+
+	_, err = s.service.SetMemberRole(ctx, &todopb.SetMemberRoleRequest{
+		ProjectId: "3",
+		UserId:    "3",
+		MemberId:  "4",
+		Role:      todopb.Role_ROLE_EDITOR,
+	})
+	s.NoError(err)
+
+	_, err = s.service.AddTask(ctx, &todopb.AddTaskRequest{ProjectId: "3", UserId: "4", Title: "rent"})
+	s.NoError(err)
+
+	p, err = s.storage.ByID(ctx, "3")
+	s.NoError(err)
+	s.Equal(map[string]todopb.Role{"3": todopb.Role_ROLE_ADMIN}, p.Roles)
+}
+
+func (s *Suite) TestRemoveAdminParticipant() {
+	ctx := context.Background()
+
+	for _, memberID := range []string{"2", "3"} {
+		_, err := s.service.SetMemberRole(ctx, &todopb.SetMemberRoleRequest{
+			ProjectId: "2",
+			UserId:    "1",
+			MemberId:  memberID,
+			Role:      todopb.Role_ROLE_ADMIN,
+		})
+		s.Require().NoError(err)
+	}
+
+	request := &todopb.UpdateProjectRequest{
+		ProjectId:    "2",
+		UserId:       "2",
+		Participants: []string{"2"},
+		FieldMask:    &fieldmaskpb.FieldMask{Paths: []string{todopb.UpdateProjectParticipantsField}},
+	}
+
+	_, err := s.service.UpdateProject(ctx, request)
+	s.Equal(codes.PermissionDenied, status.Code(err))
+
+	p, err := s.storage.ByID(ctx, "2")
+	s.NoError(err)
+	s.ElementsMatch([]string{"2", "3"}, p.Participants)
+
+	request.UserId = "1"
+
+	_, err = s.service.UpdateProject(ctx, request)
+	s.NoError(err)
+
+	p, err = s.storage.ByID(ctx, "2")
+	s.NoError(err)
+	s.Equal([]string{"2"}, p.Participants)
+}
@@ -0,0 +1,251 @@
+package test
+
+import (
+	"context"
+
+	"github.com/sladonia/todo-sv/internal/authz"
+	"github.com/sladonia/todo-sv/pkg/todopb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+func (s *Suite) TestCreateSection() {
+	ctx := context.Background()
+
+	testCases := []struct {
+		name    string
+		request *todopb.CreateSectionRequest
+		errCode codes.Code
+	}{
+		{
+			name: "success",
+			request: &todopb.CreateSectionRequest{
+				ProjectId: "3",
+				UserId:    "3",
+				Name:      "Backlog",
+			},
+			errCode: codes.OK,
+		},
+		{
+			name: "project_not_found",
+			request: &todopb.CreateSectionRequest{
+				ProjectId: "unexisting",
+				UserId:    "3",
+				Name:      "Backlog",
+			},
+			errCode: codes.NotFound,
+		},
+		{
+			name: "permission_denied",
+			request: &todopb.CreateSectionRequest{
+				ProjectId: "3",
+				UserId:    "unexisting",
+				Name:      "Backlog",
+			},
+			errCode: codes.PermissionDenied,
+		},
+		{
+			name: "invalid_request",
+			request: &todopb.CreateSectionRequest{
+				ProjectId: "3",
+				UserId:    "3",
+			},
+			errCode: codes.InvalidArgument,
+		},
+	}
+
+	for _, testCase := range testCases {
+		s.Run(testCase.name, func() {
+			res, err := s.service.CreateSection(ctx, testCase.request)
+
+			st, ok := status.FromError(err)
+			if !ok {
+				s.FailNow("not a grpc error")
+			}
+
+			s.Equal(testCase.errCode, st.Code())
+
+			if err == nil {
+				p, err := s.storage.ByID(ctx, testCase.request.ProjectId)
+				s.NoError(err)
+
+				section, ok := p.Section(res.Id)
+				s.True(ok)
+				s.Equal(testCase.request.Name, section.Name)
+			}
+		})
+	}
+}
+
+func (s *Suite) TestRenameAndMoveSections() {
+	ctx := context.Background()
+
+	var ids []string
+	for _, name := range []string{"Backlog", "Doing", "Done"} {
+		section, err := s.service.CreateSection(ctx, &todopb.CreateSectionRequest{
+			ProjectId: "3",
+			UserId:    "3",
+			Name:      name,
+		})
+		s.NoError(err)
+
+		ids = append(ids, section.Id)
+	}
+
+	_, err := s.service.RenameSection(ctx, &todopb.RenameSectionRequest{
+		ProjectId: "3",
+		SectionId: ids[1],
+		UserId:    "3",
+		Name:      "In progress",
+	})
+	s.NoError(err)
+
+	_, err = s.service.MoveSection(ctx, &todopb.MoveSectionRequest{
+		ProjectId: "3",
+		SectionId: ids[2],
+		UserId:    "3",
+		Position:  0,
+	})
+	s.NoError(err)
+
+	p, err := s.storage.ByID(ctx, "3")
+	s.NoError(err)
+	s.Equal([]string{ids[2], ids[0], ids[1]}, sectionIDs(p))
+	s.Equal("In progress", p.Sections[2].Name)
+
+	_, err = s.service.MoveSection(ctx, &todopb.MoveSectionRequest{
+		ProjectId: "3",
+		SectionId: "unexisting",
+		UserId:    "3",
+	})
+	s.Equal(codes.NotFound, status.Code(err))
+
+	_, err = s.service.UpdateProject(ctx, &todopb.UpdateProjectRequest{
+		ProjectId: "3",
+		UserId:    "2",
+		Sections:  []*todopb.Section{{Id: ids[0], Name: "Todo"}, {Id: ids[2], Name: "Done"}},
+		FieldMask: &fieldmaskpb.FieldMask{Paths: []string{todopb.UpdateProjectSectionsField}},
+	})
+	s.NoError(err)
+
+	p, err = s.storage.ByID(ctx, "3")
+	s.NoError(err)
+	s.Equal([]string{ids[0], ids[2]}, sectionIDs(p))
+	s.Equal("Todo", p.Sections[0].Name)
+
+	_, err = s.service.UpdateProject(ctx, &todopb.UpdateProjectRequest{
+		ProjectId: "3",
+		UserId:    "2",
+		Sections:  []*todopb.Section{{Id: "unexisting", Name: "Todo"}},
+		FieldMask: &fieldmaskpb.FieldMask{Paths: []string{todopb.UpdateProjectSectionsField}},
+	})
+	s.Equal(codes.InvalidArgument, status.Code(err))
+}
+
+func (s *Suite) TestDeleteSection() {
+	ctx := context.Background()
+
+	doing, err := s.service.CreateSection(ctx, &todopb.CreateSectionRequest{ProjectId: "3", UserId: "3", Name: "Doing"})
+	s.NoError(err)
+
+	done, err := s.service.CreateSection(ctx, &todopb.CreateSectionRequest{ProjectId: "3", UserId: "3", Name: "Done"})
+	s.NoError(err)
+
+	_, err = s.service.UpdateTask(ctx, &todopb.UpdateTaskRequest{
+		TaskId:    "1",
+		ProjectId: "3",
+		UserId:    "3",
+		SectionId: doing.Id,
+		FieldMask: &fieldmaskpb.FieldMask{Paths: []string{todopb.UpdateTaskSectionIDField}},
+	})
+	s.NoError(err)
+
+	_, err = s.service.DeleteSection(ctx, &todopb.DeleteSectionRequest{
+		ProjectId:       "3",
+		SectionId:       doing.Id,
+		UserId:          "3",
+		MoveToSectionId: done.Id,
+	})
+	s.NoError(err)
+
+	p, err := s.storage.ByID(ctx, "3")
+	s.NoError(err)
+	s.Equal([]string{done.Id}, sectionIDs(p))
+	s.Equal(done.Id, p.Tasks["1"].SectionId)
+
+	_, err = s.service.DeleteSection(ctx, &todopb.DeleteSectionRequest{
+		ProjectId:       "3",
+		SectionId:       done.Id,
+		UserId:          "3",
+		MoveToSectionId: "unexisting",
+	})
+	s.Equal(codes.InvalidArgument, status.Code(err))
+
+	_, err = s.service.DeleteSection(ctx, &todopb.DeleteSectionRequest{
+		ProjectId:   "3",
+		SectionId:   done.Id,
+		UserId:      "3",
+		DeleteTasks: true,
+	})
+	s.NoError(err)
+
+	p, err = s.storage.ByID(ctx, "3")
+	s.NoError(err)
+	s.Empty(p.Sections)
+	s.Empty(p.Tasks)
+}
+
+func sectionIDs(p *todopb.Project) []string {
+	var ids []string
+	for _, section := range p.Sections {
+		ids = append(ids, section.Id)
+	}
+
+	return ids
+}
+
+func (s *Suite) TestDeleteSectionTasksPolicy() {
+	ctx := context.Background()
+
+	service := s.newService(authz.DefaultPolicy, authz.Policy{
+		Rules: []authz.Rule{{
+			Actions: []authz.Action{authz.ActionDeleteTask},
+			Roles:   []string{"owner"},
+			Reason:  "only owners may delete tasks",
+		}},
+	})
+
+	section, err := service.CreateSection(ctx, &todopb.CreateSectionRequest{ProjectId: "3", UserId: "3", Name: "Doing"})
+	s.Require().NoError(err)
+
+	_, err = service.UpdateTask(ctx, &todopb.UpdateTaskRequest{
+		TaskId:    "1",
+		ProjectId: "3",
+		UserId:    "3",
+		SectionId: section.Id,
+		FieldMask: &fieldmaskpb.FieldMask{Paths: []string{todopb.UpdateTaskSectionIDField}},
+	})
+	s.Require().NoError(err)
+
+	_, err = service.DeleteSection(ctx, &todopb.DeleteSectionRequest{
+		ProjectId:   "3",
+		SectionId:   section.Id,
+		UserId:      "3",
+		DeleteTasks: true,
+	})
+	s.Equal(codes.PermissionDenied, status.Code(err))
+	s.Equal("only owners may delete tasks", status.Convert(err).Message())
+
+	p, err := s.storage.ByID(ctx, "3")
+	s.NoError(err)
+	s.Contains(p.Tasks, "1")
+
+	_, err = service.DeleteSection(ctx, &todopb.DeleteSectionRequest{
+		ProjectId:   "3",
+		SectionId:   section.Id,
+		UserId:      "2",
+		DeleteTasks: true,
+	})
+	s.NoError(err)
+}
@@ -82,10 +82,9 @@ func (s *Suite) TestCreateProject() {
 				Participants: []string{"5", "6"},
 			},
 			expected: &todopb.Project{
-				Name:         "home stuff",
-				OwnerId:      ownerID,
-				Participants: []string{"5", "6"},
-				Tasks:        nil,
+				Name:    "home stuff",
+				OwnerId: ownerID,
+				Tasks:   nil,
 			},
 			errorCode: 0,
 		},
@@ -128,9 +127,8 @@ func (s *Suite) TestUpdateProject() {
 			ProjectId: "2",
 			UserId:    "1",
 			Name:      "new_name",
-			OwnerId:   "2",
 			FieldMask: &fieldmaskpb.FieldMask{
-				Paths: []string{"name", "owner_id"},
+				Paths: []string{"name"},
 			},
 		}
 
@@ -141,10 +139,24 @@ func (s *Suite) TestUpdateProject() {
 
 		s.NoError(err)
 		s.Equal(request.Name, updated.Name)
-		s.Equal(request.OwnerId, updated.OwnerId)
+		s.Equal(projectFixtureInserted1.OwnerId, updated.OwnerId)
 		s.True(updated.Version > projectFixtureInserted1.Version)
 	})
 
+	s.Run("owner_change", func() {
+		request := &todopb.UpdateProjectRequest{
+			ProjectId: "2",
+			UserId:    "1",
+			OwnerId:   "2",
+			FieldMask: &fieldmaskpb.FieldMask{
+				Paths: []string{"owner_id"},
+			},
+		}
+
+		_, err := s.service.UpdateProject(ctx, request)
+		s.Equal(codes.InvalidArgument, status.Code(err))
+	})
+
 	s.Run("invalid_request", func() {
 		request := &todopb.UpdateProjectRequest{
 			ProjectId: "2",
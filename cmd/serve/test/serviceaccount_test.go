@@ -0,0 +1,61 @@
+package test
+
+import (
+	"context"
+
+	"github.com/sladonia/todo-sv/internal/auth"
+	"github.com/sladonia/todo-sv/internal/todo"
+	"github.com/sladonia/todo-sv/pkg/todopb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *Suite) TestServiceAccountAPIKeys() {
+	ctx := context.Background()
+
+	account, err := s.service.CreateServiceAccount(ctx, &todopb.CreateServiceAccountRequest{UserId: "2", Name: "ci"})
+	s.Require().NoError(err)
+
+	_, err = s.service.CreateApiKey(ctx, &todopb.CreateApiKeyRequest{
+		UserId:           "3",
+		ServiceAccountId: account.Id,
+		Name:             "stolen",
+	})
+	s.Equal(codes.PermissionDenied, status.Code(err))
+
+	created, err := s.service.CreateApiKey(ctx, &todopb.CreateApiKeyRequest{
+		UserId:           "2",
+		ServiceAccountId: account.Id,
+		Name:             "pipeline",
+		Scope:            &todopb.ApiKeyScope{ProjectIds: []string{"3"}, Operations: []string{"AddTask"}},
+	})
+	s.Require().NoError(err)
+	s.NotEmpty(created.Key)
+
+	authenticator := todo.NewAPIKeyAuthenticator(s.serviceAccountStorage, s.log)
+
+	identity, err := authenticator.AuthenticateKey(ctx, created.Key)
+	s.Require().NoError(err)
+	s.Equal(account.Id, identity.Subject)
+	s.Equal([]string{"3"}, identity.ProjectIDs)
+	s.Equal([]string{"AddTask"}, identity.Operations)
+
+	_, err = authenticator.AuthenticateKey(ctx, created.Key+"x")
+	s.ErrorIs(err, auth.ErrInvalidAPIKey)
+
+	list, err := s.service.ListServiceAccounts(ctx, &todopb.ListServiceAccountsRequest{UserId: "2"})
+	s.NoError(err)
+	s.Require().Len(list.ServiceAccounts, 1)
+	s.Require().Len(list.ServiceAccounts[0].ApiKeys, 1)
+	s.NotNil(list.ServiceAccounts[0].ApiKeys[0].LastUsedAt)
+
+	_, err = s.service.RevokeApiKey(ctx, &todopb.RevokeApiKeyRequest{
+		UserId:           "2",
+		ServiceAccountId: account.Id,
+		ApiKeyId:         created.ApiKey.Id,
+	})
+	s.NoError(err)
+
+	_, err = authenticator.AuthenticateKey(ctx, created.Key)
+	s.ErrorIs(err, auth.ErrInvalidAPIKey)
+}
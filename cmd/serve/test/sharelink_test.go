@@ -0,0 +1,97 @@
+package test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/sladonia/todo-sv/internal/todo"
+	"github.com/sladonia/todo-sv/pkg/todopb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+func (s *Suite) TestShareLinks() {
+	ctx := context.Background()
+
+	_, err := s.service.CreateShareLink(ctx, &todopb.CreateShareLinkRequest{ProjectId: "3", UserId: "3"})
+	s.Equal(codes.PermissionDenied, status.Code(err))
+
+	_, err = s.service.CreateShareLink(ctx, &todopb.CreateShareLinkRequest{
+		ProjectId: "3",
+		UserId:    "2",
+		Ttl:       durationpb.New(48 * time.Hour),
+	})
+	s.Equal(codes.InvalidArgument, status.Code(err))
+
+	created, err := s.service.CreateShareLink(ctx, &todopb.CreateShareLinkRequest{ProjectId: "3", UserId: "2"})
+	s.Require().NoError(err)
+	s.NotEmpty(created.Token)
+
+	shared, err := s.service.GetSharedProject(ctx, &todopb.GetSharedProjectRequest{Token: created.Token})
+	s.Require().NoError(err)
+	s.Equal("different", shared.Name)
+	s.Require().Len(shared.Tasks, 1)
+	s.Equal("pay bill", shared.Tasks[0].Title)
+
+	_, err = s.service.GetSharedProject(ctx, &todopb.GetSharedProjectRequest{Token: created.Token + "x"})
+	s.Equal(codes.NotFound, status.Code(err))
+
+	handler := todo.NewShareHandler(s.service, s.log)
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, todo.SharePathPrefix+created.Token, nil))
+	s.Equal(http.StatusOK, rec.Code)
+
+	var body map[string]interface{}
+	s.NoError(json.Unmarshal(rec.Body.Bytes(), &body))
+	s.Equal("different", body["name"])
+	s.NotContains(rec.Body.String(), "participants")
+	s.NotContains(rec.Body.String(), "ownerId")
+
+	links, err := s.service.ListShareLinks(ctx, &todopb.ListShareLinksRequest{ProjectId: "3", UserId: "2"})
+	s.NoError(err)
+	s.Require().Len(links.ShareLinks, 1)
+	s.Equal(uint64(2), links.ShareLinks[0].AccessCount)
+
+	_, err = s.service.RevokeShareLink(ctx, &todopb.RevokeShareLinkRequest{
+		ProjectId:   "3",
+		UserId:      "2",
+		ShareLinkId: created.ShareLink.Id,
+	})
+	s.NoError(err)
+
+	_, err = s.service.GetSharedProject(ctx, &todopb.GetSharedProjectRequest{Token: created.Token})
+	s.Equal(codes.NotFound, status.Code(err))
+
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, todo.SharePathPrefix+created.Token, nil))
+	s.Equal(http.StatusNotFound, rec.Code)
+}
+
+func (s *Suite) TestSharedProjectRedactsMentions() {
+	ctx := context.Background()
+
+	_, err := s.service.AddTask(ctx, &todopb.AddTaskRequest{
+		ProjectId:   "3",
+		UserId:      "2",
+		Title:       "call @3",
+		Description: "ask @3 about the bill, cc me@example.com",
+	})
+	s.Require().NoError(err)
+
+	created, err := s.service.CreateShareLink(ctx, &todopb.CreateShareLinkRequest{ProjectId: "3", UserId: "2"})
+	s.Require().NoError(err)
+
+	shared, err := s.service.GetSharedProject(ctx, &todopb.GetSharedProjectRequest{Token: created.Token})
+	s.Require().NoError(err)
+	s.Require().Len(shared.Tasks, 2)
+
+	task := shared.Tasks[1]
+	s.Equal("call @[hidden]", task.Title)
+	s.Equal("ask @[hidden] about the bill, cc me@example.com", task.Description)
+	s.Empty(task.MentionedUserIds)
+}
@@ -0,0 +1,118 @@
+package test
+
+import (
+	"context"
+
+	"github.com/sladonia/todo-sv/pkg/todopb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+func (s *Suite) TestDefaultStatusWorkflow() {
+	ctx := context.Background()
+
+	_, err := s.service.UpdateTask(ctx, &todopb.UpdateTaskRequest{
+		TaskId:     "1",
+		ProjectId:  "3",
+		UserId:     "3",
+		IsFinished: true,
+		FieldMask:  &fieldmaskpb.FieldMask{Paths: []string{todopb.UpdateTaskIsFinishedField}},
+	})
+	s.NoError(err)
+
+	p, err := s.storage.ByID(ctx, "3")
+	s.NoError(err)
+	s.Equal(todopb.StatusDone, p.Tasks["1"].Status)
+
+	_, err = s.service.UpdateTask(ctx, &todopb.UpdateTaskRequest{
+		TaskId:    "1",
+		ProjectId: "3",
+		UserId:    "3",
+		Status:    todopb.StatusOpen,
+		FieldMask: &fieldmaskpb.FieldMask{Paths: []string{todopb.UpdateTaskStatusField}},
+	})
+	s.NoError(err)
+
+	p, err = s.storage.ByID(ctx, "3")
+	s.NoError(err)
+	s.False(p.Tasks["1"].IsFinished)
+}
+
+func (s *Suite) TestCustomStatusWorkflow() {
+	ctx := context.Background()
+
+	_, err := s.service.UpdateProject(ctx, &todopb.UpdateProjectRequest{
+		ProjectId: "3",
+		UserId:    "2",
+		Statuses: []*todopb.TaskStatus{
+			{Id: "todo", Transitions: []string{"review"}},
+			{Id: "review", Transitions: []string{"todo", "released"}},
+			{Id: "released", IsDone: true},
+		},
+		FieldMask: &fieldmaskpb.FieldMask{Paths: []string{todopb.UpdateProjectStatusesField}},
+	})
+	s.NoError(err)
+
+	p, err := s.storage.ByID(ctx, "3")
+	s.NoError(err)
+	s.Equal("todo", p.Tasks["1"].Status)
+
+	testCases := []struct {
+		name    string
+		status  string
+		errCode codes.Code
+	}{
+		{
+			name:    "transition_not_allowed",
+			status:  "released",
+			errCode: codes.FailedPrecondition,
+		},
+		{
+			name:    "unknown_status",
+			status:  "unexisting",
+			errCode: codes.InvalidArgument,
+		},
+		{
+			name:    "to_review",
+			status:  "review",
+			errCode: codes.OK,
+		},
+		{
+			name:    "to_released",
+			status:  "released",
+			errCode: codes.OK,
+		},
+	}
+
+	for _, testCase := range testCases {
+		s.Run(testCase.name, func() {
+			_, err := s.service.UpdateTask(ctx, &todopb.UpdateTaskRequest{
+				TaskId:    "1",
+				ProjectId: "3",
+				UserId:    "3",
+				Status:    testCase.status,
+				FieldMask: &fieldmaskpb.FieldMask{Paths: []string{todopb.UpdateTaskStatusField}},
+			})
+
+			st, ok := status.FromError(err)
+			if !ok {
+				s.FailNow("not a grpc error")
+			}
+
+			s.Equal(testCase.errCode, st.Code())
+		})
+	}
+
+	p, err = s.storage.ByID(ctx, "3")
+	s.NoError(err)
+	s.True(p.Tasks["1"].IsFinished)
+
+	_, err = s.service.UpdateProject(ctx, &todopb.UpdateProjectRequest{
+		ProjectId: "3",
+		UserId:    "2",
+		Statuses:  []*todopb.TaskStatus{{Id: "todo"}},
+		FieldMask: &fieldmaskpb.FieldMask{Paths: []string{todopb.UpdateProjectStatusesField}},
+	})
+	s.Equal(codes.InvalidArgument, status.Code(err))
+}
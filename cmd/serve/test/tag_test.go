@@ -0,0 +1,108 @@
+package test
+
+import (
+	"context"
+
+	"github.com/sladonia/todo-sv/pkg/todopb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *Suite) TestRenameTag() {
+	ctx := context.Background()
+
+	_, err := s.service.UpsertTag(ctx, &todopb.UpsertTagRequest{
+		UserId:      "3",
+		Name:        "home",
+		Color:       "#00ff00",
+		Description: "household chores",
+	})
+	s.NoError(err)
+
+	testCases := []struct {
+		name    string
+		request *todopb.RenameTagRequest
+		errCode codes.Code
+		updated uint32
+	}{
+		{
+			name: "success",
+			request: &todopb.RenameTagRequest{
+				UserId:  "3",
+				Name:    "home",
+				NewName: "house",
+			},
+			errCode: codes.OK,
+			updated: 1,
+		},
+		{
+			name: "unused_tag",
+			request: &todopb.RenameTagRequest{
+				UserId:  "3",
+				Name:    "unexisting",
+				NewName: "house",
+			},
+			errCode: codes.OK,
+		},
+		{
+			name: "invalid_request",
+			request: &todopb.RenameTagRequest{
+				UserId: "3",
+				Name:   "home",
+			},
+			errCode: codes.InvalidArgument,
+		},
+	}
+
+	for _, testCase := range testCases {
+		s.Run(testCase.name, func() {
+			res, err := s.service.RenameTag(ctx, testCase.request)
+
+			st, ok := status.FromError(err)
+			if !ok {
+				s.FailNow("not a grpc error")
+			}
+
+			s.Equal(testCase.errCode, st.Code())
+
+			if err == nil {
+				s.Equal(testCase.updated, res.UpdatedTasks)
+			}
+		})
+	}
+
+	p, err := s.storage.ByID(ctx, "3")
+	s.NoError(err)
+	s.ElementsMatch([]string{"house", "bills"}, p.Tasks["1"].Tags)
+
+	tag, err := s.tagStorage.ByName(ctx, "3", "house")
+	s.NoError(err)
+	s.Equal("#00ff00", tag.Color)
+}
+
+func (s *Suite) TestMergeTagsAndListTags() {
+	ctx := context.Background()
+
+	res, err := s.service.MergeTags(ctx, &todopb.MergeTagsRequest{
+		UserId: "3",
+		Tags:   []string{"home", "bills"},
+		Target: "household",
+	})
+	s.NoError(err)
+	s.Equal(uint32(1), res.UpdatedProjects)
+
+	p, err := s.storage.ByID(ctx, "3")
+	s.NoError(err)
+	s.Equal([]string{"household"}, p.Tasks["1"].Tags)
+
+	_, err = s.service.UpsertTag(ctx, &todopb.UpsertTagRequest{UserId: "3", Name: "work"})
+	s.NoError(err)
+
+	tags, err := s.service.ListTags(ctx, &todopb.ListTagsRequest{UserId: "3"})
+	s.NoError(err)
+	s.Len(tags.Tags, 2)
+	s.Equal("household", tags.Tags[0].Name)
+	s.Equal(uint32(1), tags.Tags[0].UsageCount)
+	s.Equal("work", tags.Tags[1].Name)
+	s.Equal(uint32(0), tags.Tags[1].UsageCount)
+}
@@ -0,0 +1,210 @@
+package test
+
+import (
+	"context"
+	"time"
+
+	"github.com/sladonia/todo-sv/internal/authz"
+	"github.com/sladonia/todo-sv/pkg/todopb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+func (s *Suite) TestTeams() {
+	ctx := context.Background()
+
+	subscribeServer := newMockSubscribeServer()
+
+	go func() {
+		err := s.service.SubscribeToProjectsUpdates(
+			&todopb.ProjectsUpdatesRequest{UserId: "8", DeviceId: "1"},
+			subscribeServer,
+		)
+		s.Require().NoError(err)
+	}()
+
+	time.Sleep(50 * time.Millisecond)
+
+	team, err := s.service.CreateTeam(ctx, &todopb.CreateTeamRequest{
+		UserId:  "1",
+		Name:    "family",
+		Members: []string{"9", "8", "1", "8"},
+	})
+	s.Require().NoError(err)
+	s.Equal([]string{"8", "9"}, team.Members)
+
+	ev := s.nextEvent(subscribeServer, todopb.EventType_TEAM_MEMBERSHIP_CHANGED)
+	s.Equal(team.Id, ev.Team.Id)
+
+	_, err = s.service.GetTeam(ctx, &todopb.GetTeamRequest{TeamId: team.Id, UserId: "7"})
+	s.Equal(codes.PermissionDenied, status.Code(err))
+
+	list, err := s.service.ListTeams(ctx, &todopb.ListTeamsRequest{UserId: "9"})
+	s.NoError(err)
+	s.Require().Len(list.Teams, 1)
+	s.Equal(team.Id, list.Teams[0].Id)
+
+	_, err = s.service.GetProject(ctx, &todopb.GetProjectRequest{ProjectId: "2", UserId: "9"})
+	s.Equal(codes.PermissionDenied, status.Code(err))
+
+	_, err = s.service.UpdateProject(ctx, &todopb.UpdateProjectRequest{
+		ProjectId: "2",
+		UserId:    "1",
+		TeamIds:   []string{team.Id},
+		FieldMask: &fieldmaskpb.FieldMask{Paths: []string{todopb.UpdateProjectTeamIDsField}},
+	})
+	s.Require().NoError(err)
+
+	p, err := s.service.GetProject(ctx, &todopb.GetProjectRequest{ProjectId: "2", UserId: "9"})
+	s.Require().NoError(err)
+	s.Equal([]string{team.Id}, p.TeamIds)
+	s.ElementsMatch([]string{"1", "8", "9"}, p.TeamMembers)
+
+	_, err = s.service.AddTask(ctx, &todopb.AddTaskRequest{ProjectId: "2", UserId: "9", Title: "milk"})
+	s.NoError(err)
+
+	all, err := s.service.AllProjects(ctx, &todopb.AllProjectsRequest{UserId: "8"})
+	s.NoError(err)
+	s.Require().Len(all.Projects, 1)
+
+	_, err = s.service.UpdateTeam(ctx, &todopb.UpdateTeamRequest{
+		TeamId:    team.Id,
+		UserId:    "8",
+		Members:   []string{"9"},
+		FieldMask: &fieldmaskpb.FieldMask{Paths: []string{todopb.UpdateTeamMembersField}},
+	})
+	s.Equal(codes.PermissionDenied, status.Code(err))
+
+	updatedTeam, err := s.service.UpdateTeam(ctx, &todopb.UpdateTeamRequest{
+		TeamId:    team.Id,
+		UserId:    "1",
+		Members:   []string{"9"},
+		FieldMask: &fieldmaskpb.FieldMask{Paths: []string{todopb.UpdateTeamMembersField}},
+	})
+	s.Require().NoError(err)
+	s.Equal("family", updatedTeam.Name)
+	s.Equal([]string{"9"}, updatedTeam.Members)
+
+	ev = s.nextEvent(subscribeServer, todopb.EventType_TEAM_MEMBERSHIP_CHANGED)
+	s.NotContains(ev.Team.Members, "8")
+
+	_, err = s.service.GetProject(ctx, &todopb.GetProjectRequest{ProjectId: "2", UserId: "8"})
+	s.Equal(codes.PermissionDenied, status.Code(err))
+
+	_, err = s.service.DeleteTeam(ctx, &todopb.DeleteTeamRequest{TeamId: team.Id, UserId: "1"})
+	s.Require().NoError(err)
+
+	p, err = s.storage.ByID(ctx, "2")
+	s.NoError(err)
+	s.Empty(p.TeamIds)
+	s.Empty(p.TeamMembers)
+
+	_, err = s.service.GetProject(ctx, &todopb.GetProjectRequest{ProjectId: "2", UserId: "9"})
+	s.Equal(codes.PermissionDenied, status.Code(err))
+}
+
+func (s *Suite) TestShareProjectWithTeam() {
+	ctx := context.Background()
+
+	team, err := s.service.CreateTeam(ctx, &todopb.CreateTeamRequest{UserId: "5", Name: "other", Members: []string{"6"}})
+	s.Require().NoError(err)
+
+	_, err = s.service.CreateProject(ctx, &todopb.CreateProjectRequest{
+		Name:    "trip",
+		OwnerId: "1",
+		TeamIds: []string{team.Id},
+	})
+	s.Equal(codes.PermissionDenied, status.Code(err))
+
+	_, err = s.service.CreateProject(ctx, &todopb.CreateProjectRequest{
+		Name:    "trip",
+		OwnerId: "1",
+		TeamIds: []string{"unknown"},
+	})
+	s.Equal(codes.InvalidArgument, status.Code(err))
+
+	project, err := s.service.CreateProject(ctx, &todopb.CreateProjectRequest{
+		Name:    "trip",
+		OwnerId: "6",
+		TeamIds: []string{team.Id},
+	})
+	s.Require().NoError(err)
+	s.ElementsMatch([]string{"5", "6"}, project.TeamMembers)
+
+	child, err := s.service.CreateProject(ctx, &todopb.CreateProjectRequest{
+		Name:     "tickets",
+		OwnerId:  "6",
+		ParentId: project.Id,
+	})
+	s.Require().NoError(err)
+	s.Contains(child.InheritedParticipants, "5")
+
+	_, err = s.service.UpdateTeam(ctx, &todopb.UpdateTeamRequest{
+		TeamId:    team.Id,
+		UserId:    "5",
+		Members:   []string{"6", "7"},
+		FieldMask: &fieldmaskpb.FieldMask{Paths: []string{todopb.UpdateTeamMembersField}},
+	})
+	s.Require().NoError(err)
+
+	child, err = s.service.GetProject(ctx, &todopb.GetProjectRequest{ProjectId: child.Id, UserId: "7"})
+	s.Require().NoError(err)
+	s.Contains(child.InheritedParticipants, "7")
+}
+
+func (s *Suite) TestShareProjectWithTeamPolicy() {
+	ctx := context.Background()
+
+	service := s.newService(authz.DefaultPolicy, authz.Policy{
+		Rules: []authz.Rule{{
+			Actions: []authz.Action{authz.ActionManageMembers},
+			Roles:   []string{"admin"},
+			Reason:  "only admins may manage members",
+		}},
+	})
+
+	team, err := service.CreateTeam(ctx, &todopb.CreateTeamRequest{UserId: "2", Name: "work", Members: []string{"5"}})
+	s.Require().NoError(err)
+
+	_, err = service.CreateProject(ctx, &todopb.CreateProjectRequest{
+		Name:    "trip",
+		OwnerId: "2",
+		TeamIds: []string{team.Id},
+	})
+	s.Equal(codes.PermissionDenied, status.Code(err))
+	s.Equal("only admins may manage members", status.Convert(err).Message())
+
+	_, err = service.UpdateProject(ctx, &todopb.UpdateProjectRequest{
+		ProjectId: "3",
+		UserId:    "2",
+		TeamIds:   []string{team.Id},
+		FieldMask: &fieldmaskpb.FieldMask{Paths: []string{todopb.UpdateProjectTeamIDsField}},
+	})
+	s.Equal(codes.PermissionDenied, status.Code(err))
+
+	_, err = service.UpdateProject(ctx, &todopb.UpdateProjectRequest{
+		ProjectId: "3",
+		UserId:    "2",
+		Name:      "renamed",
+		FieldMask: &fieldmaskpb.FieldMask{Paths: []string{todopb.UpdateProjectNameField}},
+	})
+	s.NoError(err)
+}
+
+// nextEvent returns the next event of the type, skipping the other ones.
+func (s *Suite) nextEvent(server *mockSubscribeServer, eventType todopb.EventType) *todopb.Event {
+	timeout := time.After(time.Second)
+
+	for {
+		select {
+		case ev := <-server.eventCh:
+			if ev.Type == eventType {
+				return ev
+			}
+		case <-timeout:
+			s.FailNow("event not received", eventType.String())
+			return nil
+		}
+	}
+}
@@ -0,0 +1,132 @@
+package test
+
+import (
+	"context"
+	"time"
+
+	"github.com/sladonia/todo-sv/pkg/todopb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+func (s *Suite) TestCreateProjectFromTemplate() {
+	ctx := context.Background()
+
+	template, err := s.service.CreateTemplate(ctx, &todopb.CreateTemplateRequest{
+		OwnerId: "1",
+		Name:    "release",
+		Tasks: []*todopb.TemplateTask{
+			{Title: "freeze", Tags: []string{"release"}, DueOffset: durationpb.New(24 * time.Hour)},
+			{Title: "announce", IsImportant: true},
+		},
+	})
+	s.NoError(err)
+
+	testCases := []struct {
+		name    string
+		request *todopb.CreateProjectFromTemplateRequest
+		errCode codes.Code
+	}{
+		{
+			name: "success",
+			request: &todopb.CreateProjectFromTemplateRequest{
+				TemplateId:   template.Id,
+				OwnerId:      "1",
+				Name:         "release 1.2",
+				Participants: []string{"2"},
+			},
+			errCode: codes.OK,
+		},
+		{
+			name: "template_not_found",
+			request: &todopb.CreateProjectFromTemplateRequest{
+				TemplateId: "unexisting",
+				OwnerId:    "1",
+				Name:       "release 1.2",
+			},
+			errCode: codes.NotFound,
+		},
+		{
+			name: "permission_denied",
+			request: &todopb.CreateProjectFromTemplateRequest{
+				TemplateId: template.Id,
+				OwnerId:    "2",
+				Name:       "release 1.2",
+			},
+			errCode: codes.PermissionDenied,
+		},
+		{
+			name: "invalid_request",
+			request: &todopb.CreateProjectFromTemplateRequest{
+				TemplateId: template.Id,
+				OwnerId:    "1",
+			},
+			errCode: codes.InvalidArgument,
+		},
+	}
+
+	for _, testCase := range testCases {
+		s.Run(testCase.name, func() {
+			res, err := s.service.CreateProjectFromTemplate(ctx, testCase.request)
+
+			st, ok := status.FromError(err)
+			if !ok {
+				s.FailNow("not a grpc error")
+			}
+
+			s.Equal(testCase.errCode, st.Code())
+
+			if err == nil {
+				p, err := s.storage.ByID(ctx, res.Id)
+				s.NoError(err)
+				s.Equal(testCase.request.Name, p.Name)
+				s.Len(p.Tasks, 2)
+
+				for id, task := range p.Tasks {
+					s.Equal(id, task.Id)
+
+					if task.Title == "freeze" {
+						s.Equal(p.CreatedAt.AsTime().Add(24*time.Hour), task.DueAt.AsTime())
+					} else {
+						s.Nil(task.DueAt)
+					}
+				}
+			}
+		})
+	}
+}
+
+func (s *Suite) TestSaveProjectAsTemplate() {
+	ctx := context.Background()
+
+	template, err := s.service.SaveProjectAsTemplate(ctx, &todopb.SaveProjectAsTemplateRequest{
+		ProjectId: "3",
+		UserId:    "3",
+		Name:      "bills",
+	})
+	s.NoError(err)
+	s.Len(template.Tasks, 1)
+	s.Equal("pay bill", template.Tasks[0].Title)
+
+	_, err = s.service.SaveProjectAsTemplate(ctx, &todopb.SaveProjectAsTemplateRequest{
+		ProjectId: "3",
+		UserId:    "unexisting",
+		Name:      "bills",
+	})
+	s.Equal(codes.PermissionDenied, status.Code(err))
+
+	res, err := s.service.ListTemplates(ctx, &todopb.ListTemplatesRequest{UserId: "3"})
+	s.NoError(err)
+	s.Len(res.Templates, 1)
+
+	_, err = s.service.DeleteTemplate(ctx, &todopb.DeleteTemplateRequest{TemplateId: template.Id, UserId: "2"})
+	s.Equal(codes.PermissionDenied, status.Code(err))
+
+	_, err = s.service.DeleteTemplate(ctx, &todopb.DeleteTemplateRequest{TemplateId: template.Id, UserId: "3"})
+	s.NoError(err)
+
+	res, err = s.service.ListTemplates(ctx, &todopb.ListTemplatesRequest{UserId: "3"})
+	s.NoError(err)
+	s.Empty(res.Templates)
+}
@@ -0,0 +1,194 @@
+package test
+
+import (
+	"context"
+	"time"
+
+	"github.com/sladonia/todo-sv/internal/todo"
+	"github.com/sladonia/todo-sv/pkg/todopb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *Suite) TestStartAndStopTimer() {
+	ctx := context.Background()
+
+	testCases := []struct {
+		name    string
+		request *todopb.StartTimerRequest
+		errCode codes.Code
+	}{
+		{
+			name: "success",
+			request: &todopb.StartTimerRequest{
+				ProjectId: "3",
+				TaskId:    "1",
+				UserId:    "3",
+			},
+			errCode: codes.OK,
+		},
+		{
+			name: "already_running",
+			request: &todopb.StartTimerRequest{
+				ProjectId: "3",
+				TaskId:    "1",
+				UserId:    "3",
+			},
+			errCode: codes.FailedPrecondition,
+		},
+		{
+			name: "task_not_found",
+			request: &todopb.StartTimerRequest{
+				ProjectId: "3",
+				TaskId:    "unexisting",
+				UserId:    "2",
+			},
+			errCode: codes.NotFound,
+		},
+		{
+			name: "permission_denied",
+			request: &todopb.StartTimerRequest{
+				ProjectId: "3",
+				TaskId:    "1",
+				UserId:    "unexisting",
+			},
+			errCode: codes.PermissionDenied,
+		},
+	}
+
+	for _, testCase := range testCases {
+		s.Run(testCase.name, func() {
+			_, err := s.service.StartTimer(ctx, testCase.request)
+
+			st, ok := status.FromError(err)
+			if !ok {
+				s.FailNow("not a grpc error")
+			}
+
+			s.Equal(testCase.errCode, st.Code())
+		})
+	}
+
+	stopped, err := s.service.StopTimer(ctx, &todopb.StopTimerRequest{UserId: "3"})
+	s.NoError(err)
+	s.NotNil(stopped.EndedAt)
+
+	_, err = s.service.StopTimer(ctx, &todopb.StopTimerRequest{UserId: "3"})
+	s.Equal(codes.NotFound, status.Code(err))
+}
+
+func (s *Suite) TestTimeReport() {
+	ctx := context.Background()
+
+	start := time.Date(2022, 6, 1, 9, 0, 0, 0, time.UTC)
+
+	entry, err := s.service.AddTimeEntry(ctx, &todopb.AddTimeEntryRequest{
+		ProjectId: "3",
+		TaskId:    "1",
+		UserId:    "3",
+		StartedAt: timestamppb.New(start),
+		EndedAt:   timestamppb.New(start.Add(time.Hour)),
+	})
+	s.NoError(err)
+
+	_, err = s.service.AddTimeEntry(ctx, &todopb.AddTimeEntryRequest{
+		ProjectId: "3",
+		TaskId:    "1",
+		UserId:    "3",
+		StartedAt: timestamppb.New(start.Add(time.Hour)),
+		EndedAt:   timestamppb.New(start),
+	})
+	s.Equal(codes.InvalidArgument, status.Code(err))
+
+	_, err = s.service.UpdateTimeEntry(ctx, &todopb.UpdateTimeEntryRequest{
+		EntryId:   entry.Id,
+		UserId:    "2",
+		StartedAt: timestamppb.New(start),
+		EndedAt:   timestamppb.New(start.Add(2 * time.Hour)),
+	})
+	s.Equal(codes.PermissionDenied, status.Code(err))
+
+	_, err = s.service.UpdateTimeEntry(ctx, &todopb.UpdateTimeEntryRequest{
+		EntryId:   entry.Id,
+		UserId:    "3",
+		StartedAt: timestamppb.New(start),
+		EndedAt:   timestamppb.New(start.Add(2 * time.Hour)),
+	})
+	s.NoError(err)
+
+	request := &todopb.TimeReportRequest{
+		UserId: "3",
+		From:   timestamppb.New(start.Add(-time.Hour)),
+		To:     timestamppb.New(start.Add(time.Hour)),
+	}
+
+	report, err := s.service.TimeReport(ctx, request)
+	s.NoError(err)
+	s.Len(report.Rows, 2)
+
+	for _, row := range report.Rows {
+		s.Equal("3", row.ProjectId)
+		s.Equal("3", row.UserId)
+		s.Equal(2*time.Hour, row.Duration.AsDuration())
+	}
+
+	request.Format = todopb.TimeReportFormat_TIME_REPORT_CSV
+
+	report, err = s.service.TimeReport(ctx, request)
+	s.NoError(err)
+	s.Equal("project_id,tag,user_id,seconds\n3,bills,3,7200\n3,home,3,7200\n", string(report.Csv))
+
+	_, err = s.service.SetMemberRole(ctx, &todopb.SetMemberRoleRequest{
+		ProjectId: "3",
+		UserId:    "2",
+		MemberId:  "3",
+		Role:      todopb.Role_ROLE_VIEWER,
+	})
+	s.Require().NoError(err)
+
+	request.Format = todopb.TimeReportFormat_TIME_REPORT_ROWS
+
+	report, err = s.service.TimeReport(ctx, request)
+	s.NoError(err)
+	s.Empty(report.Rows)
+}
+
+func (s *Suite) TestDeleteTaskDeletesTimeEntries() {
+	ctx := context.Background()
+
+	start := time.Date(2022, 6, 1, 9, 0, 0, 0, time.UTC)
+
+	entry, err := s.service.AddTimeEntry(ctx, &todopb.AddTimeEntryRequest{
+		ProjectId: "3",
+		TaskId:    "1",
+		UserId:    "3",
+		StartedAt: timestamppb.New(start),
+		EndedAt:   timestamppb.New(start.Add(time.Hour)),
+	})
+	s.NoError(err)
+
+	_, err = s.service.DeleteTask(ctx, &todopb.DeleteTaskRequest{ProjectId: "3", TaskId: "1", UserId: "2"})
+	s.NoError(err)
+
+	_, err = s.timeEntryStorage.ByID(ctx, entry.Id)
+	s.ErrorIs(err, todo.ErrTimeEntryNotFound)
+}
+
+func (s *Suite) TestSingleRunningTimer() {
+	ctx := context.Background()
+
+	request := &todopb.StartTimerRequest{ProjectId: "3", TaskId: "1", UserId: "3"}
+
+	err := s.timeEntryStorage.Insert(ctx, todopb.NewRunningTimeEntry(request))
+	s.Require().NoError(err)
+
+	err = s.timeEntryStorage.Insert(ctx, todopb.NewRunningTimeEntry(request))
+	s.ErrorIs(err, todo.ErrTimerRunning)
+
+	_, err = s.service.StopTimer(ctx, &todopb.StopTimerRequest{UserId: "3"})
+	s.Require().NoError(err)
+
+	err = s.timeEntryStorage.Insert(ctx, todopb.NewRunningTimeEntry(request))
+	s.NoError(err)
+}
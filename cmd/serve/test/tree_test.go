@@ -0,0 +1,126 @@
+package test
+
+import (
+	"context"
+
+	"github.com/sladonia/todo-sv/pkg/todopb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+func (s *Suite) TestProjectTree() {
+	ctx := context.Background()
+
+	folder, err := s.service.CreateProject(ctx, &todopb.CreateProjectRequest{
+		Name:         "department",
+		OwnerId:      "5",
+		Participants: []string{"6"},
+	})
+	s.Require().NoError(err)
+
+	s.acceptInvitations(ctx, "6")
+
+	child, err := s.service.CreateProject(ctx, &todopb.CreateProjectRequest{
+		Name:     "backend",
+		OwnerId:  "5",
+		ParentId: folder.Id,
+	})
+	s.Require().NoError(err)
+	s.ElementsMatch([]string{"5", "6"}, child.InheritedParticipants)
+
+	_, err = s.service.CreateProject(ctx, &todopb.CreateProjectRequest{
+		Name:     "sneaky",
+		OwnerId:  "7",
+		ParentId: folder.Id,
+	})
+	s.Equal(codes.PermissionDenied, status.Code(err))
+
+	_, err = s.service.GetProject(ctx, &todopb.GetProjectRequest{ProjectId: child.Id, UserId: "6"})
+	s.NoError(err)
+
+	all, err := s.service.AllProjects(ctx, &todopb.AllProjectsRequest{UserId: "6"})
+	s.NoError(err)
+	s.Len(all.Projects, 2)
+
+	tree, err := s.service.GetProjectTree(ctx, &todopb.GetProjectTreeRequest{UserId: "5"})
+	s.NoError(err)
+	s.Require().Len(tree.Roots, 1)
+	s.Equal(folder.Id, tree.Roots[0].Project.Id)
+	s.Require().Len(tree.Roots[0].Children, 1)
+	s.Equal(child.Id, tree.Roots[0].Children[0].Project.Id)
+
+	_, err = s.service.MoveProject(ctx, &todopb.MoveProjectRequest{
+		ProjectId: folder.Id,
+		UserId:    "5",
+		ParentId:  child.Id,
+	})
+	s.Equal(codes.FailedPrecondition, status.Code(err))
+
+	_, err = s.service.UpdateProject(ctx, &todopb.UpdateProjectRequest{
+		ProjectId:    folder.Id,
+		UserId:       "5",
+		Participants: []string{"7"},
+		FieldMask:    &fieldmaskpb.FieldMask{Paths: []string{todopb.UpdateProjectParticipantsField}},
+	})
+	s.NoError(err)
+
+	p, err := s.storage.ByID(ctx, child.Id)
+	s.NoError(err)
+	s.ElementsMatch([]string{"5"}, p.InheritedParticipants)
+
+	_, err = s.service.GetProject(ctx, &todopb.GetProjectRequest{ProjectId: child.Id, UserId: "6"})
+	s.Equal(codes.PermissionDenied, status.Code(err))
+
+	_, err = s.service.DeleteProject(ctx, &todopb.DeleteProjectRequest{ProjectId: folder.Id, UserId: "5"})
+	s.Equal(codes.FailedPrecondition, status.Code(err))
+
+	_, err = s.service.MoveProject(ctx, &todopb.MoveProjectRequest{ProjectId: child.Id, UserId: "5"})
+	s.NoError(err)
+
+	p, err = s.storage.ByID(ctx, child.Id)
+	s.NoError(err)
+	s.Empty(p.ParentId)
+	s.Empty(p.InheritedParticipants)
+}
+
+func (s *Suite) TestChildProjectInheritsRoles() {
+	ctx := context.Background()
+
+	_, err := s.service.SetMemberRole(ctx, &todopb.SetMemberRoleRequest{
+		ProjectId: "2",
+		UserId:    "1",
+		MemberId:  "2",
+		Role:      todopb.Role_ROLE_VIEWER,
+	})
+	s.Require().NoError(err)
+
+	child, err := s.service.CreateProject(ctx, &todopb.CreateProjectRequest{
+		Name:     "groceries",
+		OwnerId:  "1",
+		ParentId: "2",
+	})
+	s.Require().NoError(err)
+
+	role, ok := child.RoleOf("2")
+	s.True(ok)
+	s.Equal(todopb.Role_ROLE_VIEWER, role)
+
+	_, err = s.service.AddTask(ctx, &todopb.AddTaskRequest{ProjectId: child.Id, UserId: "2", Title: "milk"})
+	s.Equal(codes.PermissionDenied, status.Code(err))
+
+	_, err = s.service.AddTask(ctx, &todopb.AddTaskRequest{ProjectId: child.Id, UserId: "3", Title: "milk"})
+	s.NoError(err)
+
+	_, err = s.service.SetMemberRole(ctx, &todopb.SetMemberRoleRequest{
+		ProjectId: child.Id,
+		UserId:    "1",
+		MemberId:  "2",
+		Role:      todopb.Role_ROLE_EDITOR,
+	})
+	s.Equal(codes.FailedPrecondition, status.Code(err))
+
+	p, err := s.storage.ByID(ctx, child.Id)
+	s.NoError(err)
+	s.Empty(p.Participants)
+}
@@ -0,0 +1,100 @@
+package auth
+
+import (
+	"context"
+	"strings"
+
+	"github.com/sladonia/todo-sv/pkg/set"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+const (
+	projectIDField protoreflect.Name = "project_id"
+	parentIDField  protoreflect.Name = "parent_id"
+)
+
+// KeyAuthenticator resolves API keys sent in the x-api-key header.
+type KeyAuthenticator interface {
+	// AuthenticateKey returns ErrInvalidAPIKey for unknown or revoked keys.
+	AuthenticateKey(ctx context.Context, key string) (*KeyIdentity, error)
+}
+
+// KeyIdentity is the caller authenticated by an API key. Empty project ids
+// and operations do not limit the key.
+type KeyIdentity struct {
+	Subject    string
+	ProjectIDs []string
+	Operations []string
+}
+
+func (k *KeyIdentity) allowsMethod(fullMethod string) bool {
+	if len(k.Operations) == 0 {
+		return true
+	}
+
+	operation := fullMethod[strings.LastIndex(fullMethod, "/")+1:]
+
+	return set.NewSet(k.Operations...).Contains(operation)
+}
+
+// allowsProjects reports whether every project the request refers to is in
+// the key scope. Requests without a project are only allowed to keys that
+// are not limited to projects.
+func (k *KeyIdentity) allowsProjects(msg protoreflect.Message) bool {
+	if len(k.ProjectIDs) == 0 {
+		return true
+	}
+
+	projectIDs := requestProjectIDs(msg)
+	if len(projectIDs) == 0 {
+		return false
+	}
+
+	allowed := set.NewSet(k.ProjectIDs...)
+
+	for _, id := range projectIDs {
+		if !allowed.Contains(id) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// requestProjectIDs collects the project_id and parent_id fields of the
+// request, the latter naming the parent project of a created or moved one.
+func requestProjectIDs(msg protoreflect.Message) []string {
+	var projectIDs []string
+
+	fields := msg.Descriptor().Fields()
+
+	for i := 0; i < fields.Len(); i++ {
+		field := fields.Get(i)
+
+		switch {
+		case isProjectIDField(field.Name()) && field.Kind() == protoreflect.StringKind && !field.IsList():
+			if id := msg.Get(field).String(); id != "" {
+				projectIDs = append(projectIDs, id)
+			}
+		case field.Kind() == protoreflect.MessageKind && !field.IsList() && !field.IsMap() && msg.Has(field):
+			projectIDs = append(projectIDs, requestProjectIDs(msg.Get(field).Message())...)
+		}
+	}
+
+	return projectIDs
+}
+
+func isProjectIDField(name protoreflect.Name) bool {
+	return name == projectIDField || name == parentIDField
+}
+
+type keyIdentityKey struct{}
+
+func withKeyIdentity(ctx context.Context, identity *KeyIdentity) context.Context {
+	return context.WithValue(WithSubject(ctx, identity.Subject), keyIdentityKey{}, identity)
+}
+
+func keyIdentity(ctx context.Context) (*KeyIdentity, bool) {
+	identity, ok := ctx.Value(keyIdentityKey{}).(*KeyIdentity)
+	return identity, ok
+}
@@ -0,0 +1,313 @@
+package auth
+
+import (
+	"context"
+	"crypto"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/sladonia/todo-sv/pkg/todopb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+var testSecret = []byte("secret")
+
+func encodeSegment(t *testing.T, v interface{}) string {
+	b, err := json.Marshal(v)
+	require.NoError(t, err)
+
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+func signHS256(t *testing.T, kid string, claims map[string]interface{}) string {
+	input := encodeSegment(t, map[string]string{"alg": algHS256, "kid": kid}) + "." + encodeSegment(t, claims)
+
+	mac := hmac.New(sha256.New, testSecret)
+	mac.Write([]byte(input))
+
+	return input + "." + base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+func signRS256(t *testing.T, key *rsa.PrivateKey, kid string, claims map[string]interface{}) string {
+	input := encodeSegment(t, map[string]string{"alg": algRS256, "kid": kid}) + "." + encodeSegment(t, claims)
+	digest := sha256.Sum256([]byte(input))
+
+	signature, err := rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, digest[:])
+	require.NoError(t, err)
+
+	return input + "." + base64.RawURLEncoding.EncodeToString(signature)
+}
+
+func TestVerifyHS256(t *testing.T) {
+	keys := NewKeySet()
+	keys.AddHMACKey("", testSecret)
+
+	verifier := NewVerifier(keys, "todo", "todo-sv", 0, false)
+	exp := time.Now().Add(time.Hour).Unix()
+
+	testCases := []struct {
+		name   string
+		token  string
+		expErr error
+	}{
+		{
+			name:  "valid",
+			token: signHS256(t, "", map[string]interface{}{"sub": "1", "iss": "todo", "aud": "todo-sv", "exp": exp}),
+		},
+		{
+			name: "audience_list",
+			token: signHS256(t, "", map[string]interface{}{
+				"sub": "1", "iss": "todo", "aud": []string{"web", "todo-sv"}, "exp": exp,
+			}),
+		},
+		{
+			name: "expired",
+			token: signHS256(t, "", map[string]interface{}{
+				"sub": "1", "iss": "todo", "aud": "todo-sv", "exp": time.Now().Add(-time.Minute).Unix(),
+			}),
+			expErr: ErrTokenExpired,
+		},
+		{
+			name:   "wrong_issuer",
+			token:  signHS256(t, "", map[string]interface{}{"sub": "1", "iss": "other", "aud": "todo-sv", "exp": exp}),
+			expErr: ErrInvalidClaims,
+		},
+		{
+			name:   "tampered",
+			token:  signHS256(t, "", map[string]interface{}{"sub": "1", "iss": "todo", "aud": "todo-sv"}) + "x",
+			expErr: ErrInvalidSignature,
+		},
+		{
+			name: "alg_none",
+			token: encodeSegment(t, map[string]string{"alg": "none"}) + "." +
+				encodeSegment(t, map[string]interface{}{"sub": "1"}) + ".",
+			expErr: ErrUnsupportedAlgorithm,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			claims, err := verifier.Verify(tc.token)
+			if tc.expErr != nil {
+				assert.ErrorIs(t, err, tc.expErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, "1", claims.Subject)
+		})
+	}
+}
+
+func TestVerifyRS256FromJWKS(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	jwks := map[string]interface{}{
+		"keys": []map[string]string{{
+			"kty": "RSA",
+			"kid": "main",
+			"n":   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+			"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+		}},
+	}
+
+	b, err := json.Marshal(jwks)
+	require.NoError(t, err)
+
+	path := filepath.Join(t.TempDir(), "jwks.json")
+	require.NoError(t, os.WriteFile(path, b, 0o600))
+
+	keys := NewKeySet()
+	require.NoError(t, keys.LoadJWKS(path))
+
+	verifier := NewVerifier(keys, "", "", 0, false)
+	exp := time.Now().Add(time.Hour).Unix()
+
+	claims, err := verifier.Verify(signRS256(t, key, "main", map[string]interface{}{"sub": "2", "exp": exp}))
+	require.NoError(t, err)
+	assert.Equal(t, "2", claims.Subject)
+
+	_, err = verifier.Verify(signRS256(t, key, "rotated", map[string]interface{}{"sub": "2", "exp": exp}))
+	assert.ErrorIs(t, err, ErrUnknownKey)
+}
+
+func TestVerifyMissingExpiry(t *testing.T) {
+	keys := NewKeySet()
+	keys.AddHMACKey("", testSecret)
+
+	token := signHS256(t, "", map[string]interface{}{"sub": "1"})
+
+	_, err := NewVerifier(keys, "", "", 0, false).Verify(token)
+	assert.ErrorIs(t, err, ErrMissingExpiry)
+
+	claims, err := NewVerifier(keys, "", "", 0, true).Verify(token)
+	require.NoError(t, err)
+	assert.Equal(t, "1", claims.Subject)
+}
+
+func TestInterceptorIdentity(t *testing.T) {
+	keys := NewKeySet()
+	keys.AddHMACKey("", testSecret)
+
+	interceptor := NewInterceptor(NewVerifier(keys, "", "", 0, false), nil, ModeEnforce, true, nil, zap.NewNop())
+	unary := interceptor.Unary()
+	info := &grpc.UnaryServerInfo{FullMethod: "/todopb.ToDoService/GetProject"}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) { return req, nil }
+
+	token := signHS256(t, "", map[string]interface{}{"sub": "1", "exp": time.Now().Add(time.Hour).Unix()})
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer "+token))
+
+	res, err := unary(ctx, &todopb.GetProjectRequest{ProjectId: "2"}, info, handler)
+	require.NoError(t, err)
+	assert.Equal(t, "1", res.(*todopb.GetProjectRequest).UserId)
+
+	_, err = unary(ctx, &todopb.GetProjectRequest{ProjectId: "2", UserId: "3"}, info, handler)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	_, err = unary(ctx, &todopb.CreateProjectRequest{Name: "work", OwnerId: "3"}, info, handler)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	_, err = unary(ctx, &todopb.UploadAttachmentRequest{
+		Metadata: &todopb.AttachmentMetadata{ProjectId: "2", TaskId: "1", UserId: "3"},
+	}, info, handler)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	_, err = unary(context.Background(), &todopb.GetProjectRequest{ProjectId: "2", UserId: "1"}, info, handler)
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	certCtx := peer.NewContext(context.Background(), &peer.Peer{
+		AuthInfo: credentials.TLSInfo{State: tls.ConnectionState{
+			VerifiedChains: [][]*x509.Certificate{{{Subject: pkix.Name{CommonName: "reports"}}}},
+		}},
+	})
+
+	res, err = unary(certCtx, &todopb.GetProjectRequest{ProjectId: "2"}, info, handler)
+	require.NoError(t, err)
+	assert.Equal(t, "reports", res.(*todopb.GetProjectRequest).UserId)
+
+	transitional := NewInterceptor(NewVerifier(keys, "", "", 0, false), nil, ModeTransitional, false, nil, zap.NewNop()).Unary()
+
+	_, err = transitional(context.Background(), &todopb.GetProjectRequest{ProjectId: "2", UserId: "3"}, info, handler)
+	assert.NoError(t, err)
+
+	_, err = transitional(ctx, &todopb.GetProjectRequest{ProjectId: "2", UserId: "3"}, info, handler)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+}
+
+func TestInterceptorPublicMethods(t *testing.T) {
+	keys := NewKeySet()
+	keys.AddHMACKey("", testSecret)
+
+	publicMethod := "/todopb.ToDoService/GetSharedProject"
+	unary := NewInterceptor(
+		NewVerifier(keys, "", "", 0, false),
+		nil,
+		ModeEnforce,
+		false,
+		[]string{publicMethod},
+		zap.NewNop(),
+	).Unary()
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) { return req, nil }
+
+	_, err := unary(
+		context.Background(),
+		&todopb.GetSharedProjectRequest{Token: "tds_1_secret"},
+		&grpc.UnaryServerInfo{FullMethod: publicMethod},
+		handler,
+	)
+	assert.NoError(t, err)
+
+	_, err = unary(
+		context.Background(),
+		&todopb.GetProjectRequest{ProjectId: "2", UserId: "1"},
+		&grpc.UnaryServerInfo{FullMethod: "/todopb.ToDoService/GetProject"},
+		handler,
+	)
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+}
+
+type staticKeyAuthenticator map[string]*KeyIdentity
+
+func (a staticKeyAuthenticator) AuthenticateKey(_ context.Context, key string) (*KeyIdentity, error) {
+	identity, ok := a[key]
+	if !ok {
+		return nil, ErrInvalidAPIKey
+	}
+
+	return identity, nil
+}
+
+func TestInterceptorAPIKeyScope(t *testing.T) {
+	keys := staticKeyAuthenticator{
+		"key": {Subject: "ci", ProjectIDs: []string{"3"}, Operations: []string{"AddTask"}},
+	}
+
+	unary := NewInterceptor(NewVerifier(NewKeySet(), "", "", 0, false), keys, ModeDisabled, false, nil, zap.NewNop()).Unary()
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) { return req, nil }
+	addTask := &grpc.UnaryServerInfo{FullMethod: "/todopb.ToDoService/AddTask"}
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("x-api-key", "key"))
+
+	res, err := unary(ctx, &todopb.AddTaskRequest{ProjectId: "3", Title: "deploy"}, addTask, handler)
+	require.NoError(t, err)
+	assert.Equal(t, "ci", res.(*todopb.AddTaskRequest).UserId)
+
+	_, err = unary(ctx, &todopb.AddTaskRequest{ProjectId: "2", Title: "deploy"}, addTask, handler)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	deleteTask := &grpc.UnaryServerInfo{FullMethod: "/todopb.ToDoService/DeleteTask"}
+
+	_, err = unary(ctx, &todopb.DeleteTaskRequest{ProjectId: "3", TaskId: "1"}, deleteTask, handler)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	unknownCtx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("x-api-key", "unknown"))
+
+	_, err = unary(unknownCtx, &todopb.AddTaskRequest{ProjectId: "3", Title: "deploy"}, addTask, handler)
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+}
+
+func TestInterceptorAPIKeyParentScope(t *testing.T) {
+	keys := staticKeyAuthenticator{
+		"key": {Subject: "ci", ProjectIDs: []string{"3"}},
+	}
+
+	unary := NewInterceptor(NewVerifier(NewKeySet(), "", "", 0, false), keys, ModeDisabled, false, nil, zap.NewNop()).Unary()
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) { return req, nil }
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("x-api-key", "key"))
+
+	createProject := &grpc.UnaryServerInfo{FullMethod: "/todopb.ToDoService/CreateProject"}
+
+	_, err := unary(ctx, &todopb.CreateProjectRequest{Name: "child", ParentId: "3"}, createProject, handler)
+	assert.NoError(t, err)
+
+	_, err = unary(ctx, &todopb.CreateProjectRequest{Name: "child", ParentId: "2"}, createProject, handler)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	moveProject := &grpc.UnaryServerInfo{FullMethod: "/todopb.ToDoService/MoveProject"}
+
+	_, err = unary(ctx, &todopb.MoveProjectRequest{ProjectId: "3", ParentId: "3"}, moveProject, handler)
+	assert.NoError(t, err)
+
+	_, err = unary(ctx, &todopb.MoveProjectRequest{ProjectId: "3", ParentId: "2"}, moveProject, handler)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+}
@@ -0,0 +1,16 @@
+package auth
+
+import "context"
+
+type subjectKey struct{}
+
+// WithSubject returns the context carrying the authenticated user id.
+func WithSubject(ctx context.Context, subject string) context.Context {
+	return context.WithValue(ctx, subjectKey{}, subject)
+}
+
+// Subject returns the authenticated user id stored in the context.
+func Subject(ctx context.Context) (string, bool) {
+	subject, ok := ctx.Value(subjectKey{}).(string)
+	return subject, ok
+}
@@ -0,0 +1,17 @@
+package auth
+
+import "errors"
+
+var (
+	ErrMissingToken         = errors.New("auth: missing token")
+	ErrMalformedToken       = errors.New("auth: malformed token")
+	ErrUnsupportedAlgorithm = errors.New("auth: unsupported signing algorithm")
+	ErrUnknownKey           = errors.New("auth: unknown signing key")
+	ErrInvalidSignature     = errors.New("auth: invalid token signature")
+	ErrTokenExpired         = errors.New("auth: token expired")
+	ErrMissingExpiry        = errors.New("auth: token has no expiry")
+	ErrTokenNotValidYet     = errors.New("auth: token not valid yet")
+	ErrInvalidClaims        = errors.New("auth: invalid token claims")
+	ErrInvalidJWKS          = errors.New("auth: invalid jwks")
+	ErrInvalidAPIKey        = errors.New("auth: invalid api key")
+)
@@ -0,0 +1,337 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/sladonia/todo-sv/pkg/set"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+type Mode string
+
+const (
+	// ModeDisabled trusts the user ids sent by the clients.
+	ModeDisabled Mode = "disabled"
+	// ModeTransitional lets requests without a token through, while the ones
+	// with a token are authenticated.
+	ModeTransitional Mode = "transitional"
+	// ModeEnforce requires a valid token on every request.
+	ModeEnforce Mode = "enforce"
+)
+
+var ErrUnknownMode = errors.New("auth: unknown mode")
+
+func ParseMode(mode string) (Mode, error) {
+	switch Mode(strings.ToLower(mode)) {
+	case ModeDisabled:
+		return ModeDisabled, nil
+	case ModeTransitional:
+		return ModeTransitional, nil
+	case ModeEnforce:
+		return ModeEnforce, nil
+	}
+
+	return "", fmt.Errorf("%w: %s", ErrUnknownMode, mode)
+}
+
+const (
+	userIDField  protoreflect.Name = "user_id"
+	ownerIDField protoreflect.Name = "owner_id"
+)
+
+// Interceptor authenticates the bearer token of the requests and makes sure
+// the user id of the request belongs to the token subject. An empty user id
+// is set to the subject. With clientCertIdentity set, requests without a token
+// are authenticated by the common name of the verified client certificate.
+// Requests with an API key are authenticated by the key authenticator in any
+// mode and limited to the key scope. Requests to the public methods are let
+// through without credentials.
+type Interceptor struct {
+	verifier           *Verifier
+	keyAuthenticator   KeyAuthenticator
+	mode               Mode
+	clientCertIdentity bool
+	publicMethods      *set.Set
+	log                *zap.Logger
+}
+
+func NewInterceptor(
+	verifier *Verifier,
+	keyAuthenticator KeyAuthenticator,
+	mode Mode,
+	clientCertIdentity bool,
+	publicMethods []string,
+	log *zap.Logger,
+) *Interceptor {
+	return &Interceptor{
+		verifier:           verifier,
+		keyAuthenticator:   keyAuthenticator,
+		mode:               mode,
+		clientCertIdentity: clientCertIdentity,
+		publicMethods:      set.NewSet(publicMethods...),
+		log:                log,
+	}
+}
+
+func (i *Interceptor) Unary() grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		ctx, err := i.authenticate(ctx, info.FullMethod)
+		if err != nil {
+			return nil, err
+		}
+
+		err = checkIdentity(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+
+		err = checkKeyScope(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+func (i *Interceptor) Stream() grpc.StreamServerInterceptor {
+	return func(
+		srv interface{},
+		ss grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		ctx, err := i.authenticate(ss.Context(), info.FullMethod)
+		if err != nil {
+			return err
+		}
+
+		return handler(srv, &authenticatedStream{ServerStream: ss, ctx: ctx})
+	}
+}
+
+func (i *Interceptor) authenticate(ctx context.Context, method string) (context.Context, error) {
+	if key, ok := apiKey(ctx); ok {
+		return i.authenticateKey(ctx, method, key)
+	}
+
+	if i.mode == ModeDisabled {
+		return ctx, nil
+	}
+
+	token, err := bearerToken(ctx)
+	if errors.Is(err, ErrMissingToken) {
+		if subject, ok := clientCertSubject(ctx); ok && i.clientCertIdentity {
+			return WithSubject(ctx, subject), nil
+		}
+
+		if i.publicMethods.Contains(method) {
+			return ctx, nil
+		}
+
+		if i.mode == ModeTransitional {
+			i.log.Warn("unauthenticated request", zap.String("method", method))
+			return ctx, nil
+		}
+	}
+
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	claims, err := i.verifier.Verify(token)
+	if err != nil {
+		i.log.Debug("token verification failed", zap.String("method", method), zap.Error(err))
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	return WithSubject(ctx, claims.Subject), nil
+}
+
+func (i *Interceptor) authenticateKey(ctx context.Context, method, key string) (context.Context, error) {
+	if i.keyAuthenticator == nil {
+		return nil, status.Error(codes.Unauthenticated, ErrInvalidAPIKey.Error())
+	}
+
+	identity, err := i.keyAuthenticator.AuthenticateKey(ctx, key)
+	if err != nil {
+		if errors.Is(err, ErrInvalidAPIKey) {
+			return nil, status.Error(codes.Unauthenticated, err.Error())
+		}
+
+		i.log.Error("authenticate api key", zap.String("method", method), zap.Error(err))
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	if !identity.allowsMethod(method) {
+		return nil, status.Error(codes.PermissionDenied, fmt.Sprintf("api key does not allow %s", method))
+	}
+
+	return withKeyIdentity(ctx, identity), nil
+}
+
+func apiKey(ctx context.Context) (string, bool) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return "", false
+	}
+
+	values := md.Get("x-api-key")
+	if len(values) == 0 || values[0] == "" {
+		return "", false
+	}
+
+	return values[0], true
+}
+
+func bearerToken(ctx context.Context) (string, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return "", ErrMissingToken
+	}
+
+	values := md.Get("authorization")
+	if len(values) == 0 {
+		return "", ErrMissingToken
+	}
+
+	scheme, token, ok := strings.Cut(values[0], " ")
+	if !ok || !strings.EqualFold(scheme, "bearer") || token == "" {
+		return "", ErrMalformedToken
+	}
+
+	return token, nil
+}
+
+// clientCertSubject returns the common name of the verified client
+// certificate.
+func clientCertSubject(ctx context.Context) (string, bool) {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return "", false
+	}
+
+	tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok || len(tlsInfo.State.VerifiedChains) == 0 || len(tlsInfo.State.VerifiedChains[0]) == 0 {
+		return "", false
+	}
+
+	subject := tlsInfo.State.VerifiedChains[0][0].Subject.CommonName
+
+	return subject, subject != ""
+}
+
+// checkIdentity compares the identity fields of the request with the
+// authenticated subject. The identity is the user_id field, or owner_id for
+// requests without a user_id. Messages without either are searched for
+// nested ones, as the upload metadata.
+func checkIdentity(ctx context.Context, req interface{}) error {
+	subject, ok := Subject(ctx)
+	if !ok {
+		return nil
+	}
+
+	msg, ok := req.(proto.Message)
+	if !ok {
+		return nil
+	}
+
+	return checkMessageIdentity(msg.ProtoReflect(), subject)
+}
+
+func checkMessageIdentity(msg protoreflect.Message, subject string) error {
+	fields := msg.Descriptor().Fields()
+
+	field := fields.ByName(userIDField)
+	if field == nil {
+		field = fields.ByName(ownerIDField)
+	}
+
+	if field != nil && field.Kind() == protoreflect.StringKind && field.Cardinality() != protoreflect.Repeated {
+		userID := msg.Get(field).String()
+
+		switch userID {
+		case "":
+			msg.Set(field, protoreflect.ValueOfString(subject))
+		case subject:
+		default:
+			return status.Error(
+				codes.PermissionDenied,
+				fmt.Sprintf("%s %s does not match the authenticated user", field.Name(), userID),
+			)
+		}
+
+		return nil
+	}
+
+	for i := 0; i < fields.Len(); i++ {
+		nested := fields.Get(i)
+		if nested.Kind() != protoreflect.MessageKind || nested.IsList() || nested.IsMap() || !msg.Has(nested) {
+			continue
+		}
+
+		err := checkMessageIdentity(msg.Get(nested).Message(), subject)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func checkKeyScope(ctx context.Context, req interface{}) error {
+	identity, ok := keyIdentity(ctx)
+	if !ok {
+		return nil
+	}
+
+	msg, ok := req.(proto.Message)
+	if !ok {
+		return nil
+	}
+
+	if !identity.allowsProjects(msg.ProtoReflect()) {
+		return status.Error(codes.PermissionDenied, "api key does not allow the project")
+	}
+
+	return nil
+}
+
+type authenticatedStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *authenticatedStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *authenticatedStream) RecvMsg(m interface{}) error {
+	err := s.ServerStream.RecvMsg(m)
+	if err != nil {
+		return err
+	}
+
+	err = checkIdentity(s.ctx, m)
+	if err != nil {
+		return err
+	}
+
+	return checkKeyScope(s.ctx, m)
+}
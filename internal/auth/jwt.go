@@ -0,0 +1,214 @@
+package auth
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/hmac"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"strings"
+	"time"
+)
+
+const (
+	algHS256 = "HS256"
+	algRS256 = "RS256"
+)
+
+type Claims struct {
+	Subject   string   `json:"sub"`
+	Issuer    string   `json:"iss"`
+	Audience  audience `json:"aud"`
+	ExpiresAt int64    `json:"exp"`
+	NotBefore int64    `json:"nbf"`
+	IssuedAt  int64    `json:"iat"`
+}
+
+// audience is either a single string or a list of strings.
+type audience []string
+
+func (a *audience) UnmarshalJSON(b []byte) error {
+	if bytes.HasPrefix(b, []byte(`"`)) {
+		var single string
+
+		err := json.Unmarshal(b, &single)
+		if err != nil {
+			return err
+		}
+
+		*a = audience{single}
+
+		return nil
+	}
+
+	var list []string
+
+	err := json.Unmarshal(b, &list)
+	if err != nil {
+		return err
+	}
+
+	*a = list
+
+	return nil
+}
+
+func (a audience) contains(value string) bool {
+	for _, v := range a {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+type header struct {
+	Alg string `json:"alg"`
+	Kid string `json:"kid"`
+}
+
+// Verifier checks the token signature and the registered claims. Empty issuer
+// and audience are not checked. Tokens without an expiry are rejected unless
+// allowMissingExpiry is set.
+type Verifier struct {
+	keys               *KeySet
+	issuer             string
+	audience           string
+	leeway             time.Duration
+	allowMissingExpiry bool
+	now                func() time.Time
+}
+
+func NewVerifier(
+	keys *KeySet,
+	issuer, audience string,
+	leeway time.Duration,
+	allowMissingExpiry bool,
+) *Verifier {
+	return &Verifier{
+		keys:               keys,
+		issuer:             issuer,
+		audience:           audience,
+		leeway:             leeway,
+		allowMissingExpiry: allowMissingExpiry,
+		now:                time.Now,
+	}
+}
+
+func (v *Verifier) Verify(token string) (*Claims, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil, ErrMalformedToken
+	}
+
+	var h header
+
+	err := decodeSegment(parts[0], &h)
+	if err != nil {
+		return nil, err
+	}
+
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, ErrMalformedToken
+	}
+
+	err = v.verifySignature(h, parts[0]+"."+parts[1], signature)
+	if err != nil {
+		return nil, err
+	}
+
+	var claims Claims
+
+	err = decodeSegment(parts[1], &claims)
+	if err != nil {
+		return nil, err
+	}
+
+	err = v.verifyClaims(&claims)
+	if err != nil {
+		return nil, err
+	}
+
+	return &claims, nil
+}
+
+func (v *Verifier) verifySignature(h header, signingInput string, signature []byte) error {
+	switch h.Alg {
+	case algHS256:
+		secret, ok := v.keys.hmacKey(h.Kid)
+		if !ok {
+			return ErrUnknownKey
+		}
+
+		mac := hmac.New(sha256.New, secret)
+		mac.Write([]byte(signingInput))
+
+		if !hmac.Equal(mac.Sum(nil), signature) {
+			return ErrInvalidSignature
+		}
+	case algRS256:
+		key, ok := v.keys.rsaKey(h.Kid)
+		if !ok {
+			return ErrUnknownKey
+		}
+
+		digest := sha256.Sum256([]byte(signingInput))
+
+		err := rsa.VerifyPKCS1v15(key, crypto.SHA256, digest[:], signature)
+		if err != nil {
+			return ErrInvalidSignature
+		}
+	default:
+		return ErrUnsupportedAlgorithm
+	}
+
+	return nil
+}
+
+func (v *Verifier) verifyClaims(c *Claims) error {
+	now := v.now()
+
+	if c.Subject == "" {
+		return ErrInvalidClaims
+	}
+
+	if c.ExpiresAt == 0 && !v.allowMissingExpiry {
+		return ErrMissingExpiry
+	}
+
+	if c.ExpiresAt != 0 && !now.Before(time.Unix(c.ExpiresAt, 0).Add(v.leeway)) {
+		return ErrTokenExpired
+	}
+
+	if c.NotBefore != 0 && now.Add(v.leeway).Before(time.Unix(c.NotBefore, 0)) {
+		return ErrTokenNotValidYet
+	}
+
+	if v.issuer != "" && c.Issuer != v.issuer {
+		return ErrInvalidClaims
+	}
+
+	if v.audience != "" && !c.Audience.contains(v.audience) {
+		return ErrInvalidClaims
+	}
+
+	return nil
+}
+
+func decodeSegment(segment string, v interface{}) error {
+	b, err := base64.RawURLEncoding.DecodeString(segment)
+	if err != nil {
+		return ErrMalformedToken
+	}
+
+	err = json.Unmarshal(b, v)
+	if err != nil {
+		return ErrMalformedToken
+	}
+
+	return nil
+}
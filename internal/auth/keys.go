@@ -0,0 +1,137 @@
+package auth
+
+import (
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"os"
+)
+
+// KeySet holds the keys tokens are verified with, indexed by key id.
+type KeySet struct {
+	hmacKeys map[string][]byte
+	rsaKeys  map[string]*rsa.PublicKey
+}
+
+func NewKeySet() *KeySet {
+	return &KeySet{
+		hmacKeys: make(map[string][]byte),
+		rsaKeys:  make(map[string]*rsa.PublicKey),
+	}
+}
+
+func (s *KeySet) AddHMACKey(kid string, secret []byte) {
+	s.hmacKeys[kid] = secret
+}
+
+func (s *KeySet) AddRSAKey(kid string, key *rsa.PublicKey) {
+	s.rsaKeys[kid] = key
+}
+
+func (s *KeySet) IsEmpty() bool {
+	return len(s.hmacKeys) == 0 && len(s.rsaKeys) == 0
+}
+
+// hmacKey returns the key with the id. A token without a key id is accepted
+// when the set holds a single key of the type.
+func (s *KeySet) hmacKey(kid string) ([]byte, bool) {
+	key, ok := s.hmacKeys[kid]
+	if ok || kid != "" || len(s.hmacKeys) != 1 {
+		return key, ok
+	}
+
+	for _, key := range s.hmacKeys {
+		return key, true
+	}
+
+	return nil, false
+}
+
+func (s *KeySet) rsaKey(kid string) (*rsa.PublicKey, bool) {
+	key, ok := s.rsaKeys[kid]
+	if ok || kid != "" || len(s.rsaKeys) != 1 {
+		return key, ok
+	}
+
+	for _, key := range s.rsaKeys {
+		return key, true
+	}
+
+	return nil, false
+}
+
+type jwk struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	K   string `json:"k"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+}
+
+// LoadJWKS adds the keys of the JWKS file to the set. Symmetric ("oct") and
+// RSA keys are supported, other key types are skipped.
+func (s *KeySet) LoadJWKS(path string) error {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	var jwks struct {
+		Keys []jwk `json:"keys"`
+	}
+
+	err = json.Unmarshal(b, &jwks)
+	if err != nil {
+		return fmt.Errorf("%w: %s", ErrInvalidJWKS, err.Error())
+	}
+
+	for _, key := range jwks.Keys {
+		if key.Use != "" && key.Use != "sig" {
+			continue
+		}
+
+		switch key.Kty {
+		case "oct":
+			secret, err := base64.RawURLEncoding.DecodeString(key.K)
+			if err != nil {
+				return fmt.Errorf("%w: key %s: %s", ErrInvalidJWKS, key.Kid, err.Error())
+			}
+
+			s.AddHMACKey(key.Kid, secret)
+		case "RSA":
+			pub, err := rsaPublicKey(key.N, key.E)
+			if err != nil {
+				return fmt.Errorf("%w: key %s: %s", ErrInvalidJWKS, key.Kid, err.Error())
+			}
+
+			s.AddRSAKey(key.Kid, pub)
+		}
+	}
+
+	return nil
+}
+
+func rsaPublicKey(n, e string) (*rsa.PublicKey, error) {
+	nb, err := base64.RawURLEncoding.DecodeString(n)
+	if err != nil {
+		return nil, err
+	}
+
+	eb, err := base64.RawURLEncoding.DecodeString(e)
+	if err != nil {
+		return nil, err
+	}
+
+	exponent := new(big.Int).SetBytes(eb)
+	if !exponent.IsInt64() || exponent.Int64() < 2 {
+		return nil, fmt.Errorf("invalid exponent")
+	}
+
+	return &rsa.PublicKey{
+		N: new(big.Int).SetBytes(nb),
+		E: int(exponent.Int64()),
+	}, nil
+}
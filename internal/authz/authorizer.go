@@ -0,0 +1,85 @@
+package authz
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/sladonia/todo-sv/pkg/todopb"
+)
+
+// Action names an operation checked by the Authorizer.
+type Action string
+
+const (
+	ActionViewProject       Action = "project.view"
+	ActionUpdateProject     Action = "project.update"
+	ActionArchiveProject    Action = "project.archive"
+	ActionMoveProject       Action = "project.move"
+	ActionAddChildProject   Action = "project.add_child"
+	ActionDeleteProject     Action = "project.delete"
+	ActionManageMembers     Action = "project.manage_members"
+	ActionManageAdmins      Action = "project.manage_admins"
+	ActionTransferOwnership Action = "project.transfer_ownership"
+	ActionShareProject      Action = "project.share"
+	ActionManageSections    Action = "section.manage"
+	ActionCreateTask        Action = "task.create"
+	ActionUpdateTask        Action = "task.update"
+	ActionDeleteTask        Action = "task.delete"
+	ActionCreateComment     Action = "comment.create"
+	ActionEditComment       Action = "comment.edit"
+	ActionUploadAttachment  Action = "attachment.upload"
+	ActionTrackTime         Action = "time.track"
+)
+
+// Actions lists every action the service checks.
+var Actions = []Action{
+	ActionViewProject,
+	ActionUpdateProject,
+	ActionArchiveProject,
+	ActionMoveProject,
+	ActionAddChildProject,
+	ActionDeleteProject,
+	ActionManageMembers,
+	ActionManageAdmins,
+	ActionTransferOwnership,
+	ActionShareProject,
+	ActionManageSections,
+	ActionCreateTask,
+	ActionUpdateTask,
+	ActionDeleteTask,
+	ActionCreateComment,
+	ActionEditComment,
+	ActionUploadAttachment,
+	ActionTrackTime,
+}
+
+// Resource is the object the action is performed on. TaskID is set for the
+// actions on a single task.
+type Resource struct {
+	Project *todopb.Project
+	TaskID  string
+}
+
+// Authorizer decides whether the subject may perform the action on the
+// resource. Denials are reported with a *DeniedError.
+type Authorizer interface {
+	Authorize(ctx context.Context, subject string, action Action, resource Resource) error
+}
+
+var ErrDenied = errors.New("authz: permission denied")
+
+// DeniedError explains why the action was denied.
+type DeniedError struct {
+	Subject string
+	Action  Action
+	Reason  string
+}
+
+func (e *DeniedError) Error() string {
+	return fmt.Sprintf("%s: %s", ErrDenied.Error(), e.Reason)
+}
+
+func (e *DeniedError) Unwrap() error {
+	return ErrDenied
+}
@@ -0,0 +1,115 @@
+package authz
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/sladonia/todo-sv/pkg/todopb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testProject = &todopb.Project{
+	Id:           "1",
+	OwnerId:      "owner",
+	Participants: []string{"admin", "editor", "viewer"},
+	Roles: map[string]todopb.Role{
+		"admin":  todopb.Role_ROLE_ADMIN,
+		"viewer": todopb.Role_ROLE_VIEWER,
+	},
+}
+
+func TestDefaultPolicy(t *testing.T) {
+	a, err := NewPolicyAuthorizer(DefaultPolicy)
+	require.NoError(t, err)
+
+	cases := []struct {
+		subject string
+		action  Action
+		allowed bool
+	}{
+		{"viewer", ActionViewProject, true},
+		{"viewer", ActionUpdateTask, false},
+		{"editor", ActionDeleteTask, true},
+		{"editor", ActionManageMembers, false},
+		{"admin", ActionManageMembers, true},
+		{"admin", ActionManageAdmins, false},
+		{"admin", ActionDeleteProject, false},
+		{"owner", ActionDeleteProject, true},
+		{"owner", ActionShareProject, true},
+		{"stranger", ActionViewProject, false},
+	}
+
+	for _, c := range cases {
+		err := a.Authorize(context.Background(), c.subject, c.action, Resource{Project: testProject})
+		if c.allowed {
+			assert.NoError(t, err, "%s %s", c.subject, c.action)
+			continue
+		}
+
+		assert.ErrorIs(t, err, ErrDenied, "%s %s", c.subject, c.action)
+	}
+}
+
+func TestDeniedReason(t *testing.T) {
+	a, err := NewPolicyAuthorizer(DefaultPolicy)
+	require.NoError(t, err)
+
+	err = a.Authorize(context.Background(), "stranger", ActionViewProject, Resource{Project: testProject})
+
+	var denied *DeniedError
+	require.True(t, errors.As(err, &denied))
+	assert.Equal(t, "stranger", denied.Subject)
+	assert.Equal(t, ActionViewProject, denied.Action)
+	assert.Equal(t, "user stranger is not a member of 1 project", denied.Reason)
+}
+
+func TestLoadPolicy(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "policy.json")
+
+	err := os.WriteFile(path, []byte(`{
+		"rules": [
+			{
+				"actions": ["task.delete"],
+				"roles": ["owner"],
+				"reason": "only owners may delete tasks"
+			}
+		]
+	}`), 0o600)
+	require.NoError(t, err)
+
+	policy, err := LoadPolicy(path)
+	require.NoError(t, err)
+
+	a, err := NewPolicyAuthorizer(DefaultPolicy, policy)
+	require.NoError(t, err)
+
+	err = a.Authorize(context.Background(), "owner", ActionDeleteTask, Resource{Project: testProject, TaskID: "1"})
+	assert.NoError(t, err)
+
+	err = a.Authorize(context.Background(), "editor", ActionDeleteTask, Resource{Project: testProject, TaskID: "1"})
+
+	var denied *DeniedError
+	require.True(t, errors.As(err, &denied))
+	assert.Equal(t, "only owners may delete tasks", denied.Reason)
+
+	err = a.Authorize(context.Background(), "editor", ActionUpdateTask, Resource{Project: testProject, TaskID: "1"})
+	assert.NoError(t, err)
+}
+
+func TestInvalidPolicy(t *testing.T) {
+	_, err := NewPolicyAuthorizer(Policy{Rules: []Rule{{Actions: []Action{"task.rename"}, Roles: []string{"owner"}}}})
+	assert.ErrorIs(t, err, ErrUnknownAction)
+
+	_, err = NewPolicyAuthorizer(Policy{Rules: []Rule{{Actions: []Action{ActionDeleteTask}, Roles: []string{"guest"}}}})
+	assert.ErrorIs(t, err, ErrUnknownRole)
+
+	a, err := NewPolicyAuthorizer()
+	require.NoError(t, err)
+
+	err = a.Authorize(context.Background(), "owner", ActionViewProject, Resource{Project: testProject})
+	assert.ErrorIs(t, err, ErrDenied)
+}
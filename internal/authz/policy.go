@@ -0,0 +1,191 @@
+package authz
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/sladonia/todo-sv/pkg/set"
+	"github.com/sladonia/todo-sv/pkg/todopb"
+)
+
+var (
+	ErrUnknownAction = errors.New("authz: unknown action")
+	ErrUnknownRole   = errors.New("authz: unknown role")
+)
+
+// Policy is the declarative set of rules of the PolicyAuthorizer. A rule
+// allows its actions to the members having one of its roles. The last rule
+// mentioning an action wins, so a policy file only lists the actions it
+// changes.
+type Policy struct {
+	Rules []Rule `json:"rules"`
+}
+
+type Rule struct {
+	Actions []Action `json:"actions"`
+	// Roles is any of owner, admin, editor and viewer.
+	Roles []string `json:"roles"`
+	// Reason is reported when the action is denied.
+	Reason string `json:"reason"`
+}
+
+var roleNames = map[string]todopb.Role{
+	"owner":  todopb.Role_ROLE_OWNER,
+	"admin":  todopb.Role_ROLE_ADMIN,
+	"editor": todopb.Role_ROLE_EDITOR,
+	"viewer": todopb.Role_ROLE_VIEWER,
+}
+
+// DefaultPolicy grants the permissions of the member roles.
+var DefaultPolicy = Policy{
+	Rules: []Rule{
+		{
+			Actions: []Action{ActionViewProject},
+			Roles:   []string{"owner", "admin", "editor", "viewer"},
+		},
+		{
+			Actions: []Action{
+				ActionAddChildProject,
+				ActionManageSections,
+				ActionCreateTask,
+				ActionUpdateTask,
+				ActionDeleteTask,
+				ActionCreateComment,
+				ActionEditComment,
+				ActionUploadAttachment,
+				ActionTrackTime,
+			},
+			Roles: []string{"owner", "admin", "editor"},
+		},
+		{
+			Actions: []Action{
+				ActionUpdateProject,
+				ActionArchiveProject,
+				ActionMoveProject,
+				ActionManageMembers,
+			},
+			Roles: []string{"owner", "admin"},
+		},
+		{
+			Actions: []Action{
+				ActionDeleteProject,
+				ActionManageAdmins,
+				ActionTransferOwnership,
+				ActionShareProject,
+			},
+			Roles: []string{"owner"},
+		},
+	},
+}
+
+// LoadPolicy reads the JSON policy file.
+func LoadPolicy(path string) (Policy, error) {
+	var policy Policy
+
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return policy, err
+	}
+
+	err = json.Unmarshal(b, &policy)
+	if err != nil {
+		return policy, fmt.Errorf("parse policy %s: %w", path, err)
+	}
+
+	return policy, nil
+}
+
+type compiledRule struct {
+	roles  []todopb.Role
+	reason string
+}
+
+// PolicyAuthorizer authorizes the actions by the role of the subject in the
+// project.
+type PolicyAuthorizer struct {
+	rules map[Action]compiledRule
+}
+
+// NewPolicyAuthorizer applies the policies in order on top of each other.
+func NewPolicyAuthorizer(policies ...Policy) (*PolicyAuthorizer, error) {
+	known := set.NewSet()
+	for _, action := range Actions {
+		known.Add(string(action))
+	}
+
+	rules := make(map[Action]compiledRule)
+
+	for _, policy := range policies {
+		for _, rule := range policy.Rules {
+			compiled := compiledRule{reason: rule.Reason}
+
+			for _, name := range rule.Roles {
+				role, ok := roleNames[strings.ToLower(name)]
+				if !ok {
+					return nil, fmt.Errorf("%w: %s", ErrUnknownRole, name)
+				}
+
+				compiled.roles = append(compiled.roles, role)
+			}
+
+			for _, action := range rule.Actions {
+				if !known.Contains(string(action)) {
+					return nil, fmt.Errorf("%w: %s", ErrUnknownAction, action)
+				}
+
+				rules[action] = compiled
+			}
+		}
+	}
+
+	return &PolicyAuthorizer{rules: rules}, nil
+}
+
+func (a *PolicyAuthorizer) Authorize(_ context.Context, subject string, action Action, resource Resource) error {
+	p := resource.Project
+
+	role, ok := p.RoleOf(subject)
+	if !ok {
+		return &DeniedError{
+			Subject: subject,
+			Action:  action,
+			Reason:  fmt.Sprintf("user %s is not a member of %s project", subject, p.Id),
+		}
+	}
+
+	rule, ok := a.rules[action]
+	if !ok {
+		return &DeniedError{
+			Subject: subject,
+			Action:  action,
+			Reason:  fmt.Sprintf("no policy rule allows %s", action),
+		}
+	}
+
+	for _, allowed := range rule.roles {
+		if role == allowed {
+			return nil
+		}
+	}
+
+	reason := rule.reason
+	if reason == "" {
+		reason = fmt.Sprintf("%s is not allowed to the %s role in %s project", action, roleName(role), p.Id)
+	}
+
+	return &DeniedError{Subject: subject, Action: action, Reason: reason}
+}
+
+func roleName(role todopb.Role) string {
+	for name, r := range roleNames {
+		if r == role {
+			return name
+		}
+	}
+
+	return role.String()
+}
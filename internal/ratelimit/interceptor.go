@@ -0,0 +1,167 @@
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/sladonia/todo-sv/internal/auth"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+const retryAfterHeader = "retry-after"
+
+var identityFields = []protoreflect.Name{"user_id", "owner_id"}
+
+// Interceptor limits the requests of every user per method. The user is the
+// authenticated subject, or the user id of the request when authentication
+// is disabled. Anonymous requests are limited per remote address. It is meant
+// to be chained after the auth interceptor.
+type Interceptor struct {
+	limiter *Limiter
+	log     *zap.Logger
+}
+
+func NewInterceptor(limiter *Limiter, log *zap.Logger) *Interceptor {
+	return &Interceptor{
+		limiter: limiter,
+		log:     log,
+	}
+}
+
+func (i *Interceptor) Unary() grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		err := i.allow(ctx, caller(ctx, req), info.FullMethod)
+		if err != nil {
+			return nil, err
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+func (i *Interceptor) Stream() grpc.StreamServerInterceptor {
+	return func(
+		srv interface{},
+		ss grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		err := i.allow(ss.Context(), caller(ss.Context(), nil), info.FullMethod)
+		if err != nil {
+			return err
+		}
+
+		return handler(srv, ss)
+	}
+}
+
+// HTTP limits the requests of the anonymous HTTP handler per remote address,
+// accounting them to the method.
+func (i *Interceptor) HTTP(method string, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		userID := addrCaller(r.RemoteAddr)
+
+		wait, ok := i.limiter.Allow(userID, method)
+		if !ok {
+			i.log.Debug(
+				"rate limit exceeded",
+				zap.String("user_id", userID),
+				zap.String("method", method),
+				zap.Duration("retry_after", wait),
+			)
+
+			w.Header().Set(retryAfterHeader, retryAfterSeconds(wait))
+			http.Error(w, http.StatusText(http.StatusTooManyRequests), http.StatusTooManyRequests)
+
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+func (i *Interceptor) allow(ctx context.Context, userID, fullMethod string) error {
+	method := fullMethod[strings.LastIndex(fullMethod, "/")+1:]
+
+	wait, ok := i.limiter.Allow(userID, method)
+	if ok {
+		return nil
+	}
+
+	err := grpc.SetHeader(ctx, metadata.Pairs(retryAfterHeader, retryAfterSeconds(wait)))
+	if err != nil {
+		i.log.Debug("failed to set retry-after header", zap.Error(err))
+	}
+
+	i.log.Debug(
+		"rate limit exceeded",
+		zap.String("user_id", userID),
+		zap.String("method", method),
+		zap.Duration("retry_after", wait),
+	)
+
+	return status.Error(
+		codes.ResourceExhausted,
+		fmt.Sprintf("rate limit of %s exceeded, retry in %s", method, wait.Round(time.Millisecond)),
+	)
+}
+
+// caller identifies the user the request is accounted to.
+func caller(ctx context.Context, req interface{}) string {
+	if subject, ok := auth.Subject(ctx); ok {
+		return subject
+	}
+
+	if msg, ok := req.(proto.Message); ok {
+		fields := msg.ProtoReflect().Descriptor().Fields()
+
+		for _, name := range identityFields {
+			field := fields.ByName(name)
+			if field == nil || field.Kind() != protoreflect.StringKind || field.IsList() {
+				continue
+			}
+
+			if userID := msg.ProtoReflect().Get(field).String(); userID != "" {
+				return userID
+			}
+		}
+	}
+
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		return addrCaller(p.Addr.String())
+	}
+
+	return ""
+}
+
+// addrCaller accounts anonymous requests to the remote host.
+func addrCaller(addr string) string {
+	host, _, err := net.SplitHostPort(addr)
+	if err != nil {
+		host = addr
+	}
+
+	return "addr:" + host
+}
+
+func retryAfterSeconds(wait time.Duration) string {
+	return strconv.Itoa(int(math.Ceil(wait.Seconds())))
+}
@@ -0,0 +1,150 @@
+package ratelimit
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+var ErrInvalidLimit = errors.New("ratelimit: invalid limit")
+
+// sweepInterval is how often the buckets that have refilled are dropped.
+const sweepInterval = time.Minute
+
+// Limit allows Rate requests per second with bursts of up to Burst requests.
+// A zero Rate disables the limit.
+type Limit struct {
+	Rate  float64
+	Burst int
+}
+
+func (l Limit) unlimited() bool {
+	return l.Rate <= 0
+}
+
+// ParseMethodLimit parses the "Method=rate:burst" limit of a method, as
+// "AddTask=5:10".
+func ParseMethodLimit(s string) (string, Limit, error) {
+	method, limit, ok := strings.Cut(s, "=")
+	if !ok {
+		return "", Limit{}, fmt.Errorf("%w: %s", ErrInvalidLimit, s)
+	}
+
+	rate, burst, ok := strings.Cut(limit, ":")
+	if !ok {
+		return "", Limit{}, fmt.Errorf("%w: %s", ErrInvalidLimit, s)
+	}
+
+	r, err := strconv.ParseFloat(strings.TrimSpace(rate), 64)
+	if err != nil || r < 0 {
+		return "", Limit{}, fmt.Errorf("%w: %s", ErrInvalidLimit, s)
+	}
+
+	b, err := strconv.Atoi(strings.TrimSpace(burst))
+	if err != nil || b < 1 && r > 0 {
+		return "", Limit{}, fmt.Errorf("%w: %s", ErrInvalidLimit, s)
+	}
+
+	return strings.TrimSpace(method), Limit{Rate: r, Burst: b}, nil
+}
+
+type bucketKey struct {
+	userID string
+	method string
+}
+
+type bucket struct {
+	tokens float64
+	last   time.Time
+}
+
+func (b *bucket) refill(limit Limit, now time.Time) {
+	b.tokens = math.Min(float64(limit.Burst), b.tokens+now.Sub(b.last).Seconds()*limit.Rate)
+	b.last = now
+}
+
+// Limiter keeps a token bucket per user and method. Methods without a limit
+// of their own share the default limit, each with a separate bucket.
+type Limiter struct {
+	defaultLimit Limit
+	methodLimits map[string]Limit
+	now          func() time.Time
+
+	mu        sync.Mutex
+	buckets   map[bucketKey]*bucket
+	lastSweep time.Time
+}
+
+func NewLimiter(defaultLimit Limit, methodLimits map[string]Limit) *Limiter {
+	return &Limiter{
+		defaultLimit: defaultLimit,
+		methodLimits: methodLimits,
+		now:          time.Now,
+		buckets:      make(map[bucketKey]*bucket),
+		lastSweep:    time.Now(),
+	}
+}
+
+// Allow takes a token from the bucket of the user for the method. When the
+// bucket is empty the time until the next token is returned.
+func (l *Limiter) Allow(userID, method string) (time.Duration, bool) {
+	limit := l.limit(method)
+	if limit.unlimited() {
+		return 0, true
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	l.sweep(now)
+
+	key := bucketKey{userID: userID, method: method}
+
+	b, ok := l.buckets[key]
+	if !ok {
+		b = &bucket{tokens: float64(limit.Burst), last: now}
+		l.buckets[key] = b
+	}
+
+	b.refill(limit, now)
+
+	if b.tokens < 1 {
+		return time.Duration((1 - b.tokens) / limit.Rate * float64(time.Second)), false
+	}
+
+	b.tokens--
+
+	return 0, true
+}
+
+func (l *Limiter) limit(method string) Limit {
+	if limit, ok := l.methodLimits[method]; ok {
+		return limit
+	}
+
+	return l.defaultLimit
+}
+
+// sweep drops the buckets that have refilled, as they are equal to new ones.
+func (l *Limiter) sweep(now time.Time) {
+	if now.Sub(l.lastSweep) < sweepInterval {
+		return
+	}
+
+	l.lastSweep = now
+
+	for key, b := range l.buckets {
+		limit := l.limit(key.method)
+
+		b.refill(limit, now)
+
+		if b.tokens >= float64(limit.Burst) {
+			delete(l.buckets, key)
+		}
+	}
+}
@@ -0,0 +1,180 @@
+package ratelimit
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/sladonia/todo-sv/internal/auth"
+	"github.com/sladonia/todo-sv/pkg/todopb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+type clock struct {
+	now time.Time
+}
+
+func (c *clock) Now() time.Time {
+	return c.now
+}
+
+func newTestLimiter(defaultLimit Limit, methodLimits map[string]Limit) (*Limiter, *clock) {
+	c := &clock{now: time.Unix(1_600_000_000, 0)}
+
+	l := NewLimiter(defaultLimit, methodLimits)
+	l.now = c.Now
+	l.lastSweep = c.now
+
+	return l, c
+}
+
+func TestLimiter(t *testing.T) {
+	l, c := newTestLimiter(Limit{Rate: 1, Burst: 2}, map[string]Limit{"AddTask": {Rate: 0.5, Burst: 1}})
+
+	_, ok := l.Allow("1", "GetProject")
+	assert.True(t, ok)
+
+	_, ok = l.Allow("1", "GetProject")
+	assert.True(t, ok)
+
+	wait, ok := l.Allow("1", "GetProject")
+	assert.False(t, ok)
+	assert.Equal(t, time.Second, wait)
+
+	_, ok = l.Allow("2", "GetProject")
+	assert.True(t, ok, "users have separate buckets")
+
+	_, ok = l.Allow("1", "AddTask")
+	assert.True(t, ok, "methods have separate buckets")
+
+	wait, ok = l.Allow("1", "AddTask")
+	assert.False(t, ok)
+	assert.Equal(t, 2*time.Second, wait)
+
+	c.now = c.now.Add(time.Second)
+
+	_, ok = l.Allow("1", "GetProject")
+	assert.True(t, ok)
+
+	_, ok = l.Allow("1", "GetProject")
+	assert.False(t, ok)
+}
+
+func TestLimiterUnlimited(t *testing.T) {
+	l, _ := newTestLimiter(Limit{}, map[string]Limit{"AddTask": {Rate: 1, Burst: 1}})
+
+	for i := 0; i < 100; i++ {
+		_, ok := l.Allow("1", "GetProject")
+		require.True(t, ok)
+	}
+
+	_, ok := l.Allow("1", "AddTask")
+	assert.True(t, ok)
+
+	_, ok = l.Allow("1", "AddTask")
+	assert.False(t, ok)
+}
+
+func TestLimiterSweep(t *testing.T) {
+	l, c := newTestLimiter(Limit{Rate: 1, Burst: 5}, nil)
+
+	_, ok := l.Allow("1", "GetProject")
+	require.True(t, ok)
+
+	c.now = c.now.Add(sweepInterval)
+
+	_, ok = l.Allow("2", "GetProject")
+	require.True(t, ok)
+
+	assert.Len(t, l.buckets, 1)
+}
+
+func TestParseMethodLimit(t *testing.T) {
+	method, limit, err := ParseMethodLimit("AddTask=0.5:10")
+	require.NoError(t, err)
+	assert.Equal(t, "AddTask", method)
+	assert.Equal(t, Limit{Rate: 0.5, Burst: 10}, limit)
+
+	method, limit, err = ParseMethodLimit("GetProject=0:0")
+	require.NoError(t, err)
+	assert.Equal(t, "GetProject", method)
+	assert.Equal(t, Limit{}, limit)
+
+	for _, s := range []string{"AddTask", "AddTask=5", "AddTask=x:1", "AddTask=-1:1", "AddTask=5:0"} {
+		_, _, err = ParseMethodLimit(s)
+		assert.ErrorIs(t, err, ErrInvalidLimit, s)
+	}
+}
+
+func TestInterceptorHTTP(t *testing.T) {
+	l, _ := newTestLimiter(Limit{Rate: 1, Burst: 1}, nil)
+	i := NewInterceptor(l, zap.NewNop())
+
+	handler := i.HTTP("GetSharedProject", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	request := func(remoteAddr string) *httptest.ResponseRecorder {
+		r := httptest.NewRequest(http.MethodGet, "/share/token", nil)
+		r.RemoteAddr = remoteAddr
+
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, r)
+
+		return rec
+	}
+
+	assert.Equal(t, http.StatusOK, request("10.0.0.1:1000").Code)
+
+	rec := request("10.0.0.1:1001")
+	assert.Equal(t, http.StatusTooManyRequests, rec.Code)
+	assert.Equal(t, "1", rec.Header().Get(retryAfterHeader))
+
+	assert.Equal(t, http.StatusOK, request("10.0.0.2:1000").Code)
+}
+
+func TestInterceptor(t *testing.T) {
+	l, _ := newTestLimiter(Limit{Rate: 1, Burst: 1}, nil)
+	interceptor := NewInterceptor(l, zap.NewNop()).Unary()
+
+	info := &grpc.UnaryServerInfo{FullMethod: "/todo.ToDoService/AddTask"}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return req, nil
+	}
+
+	_, err := interceptor(context.Background(), &todopb.AddTaskRequest{UserId: "1"}, info, handler)
+	assert.NoError(t, err)
+
+	_, err = interceptor(context.Background(), &todopb.AddTaskRequest{UserId: "1"}, info, handler)
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+	ctx := auth.WithSubject(context.Background(), "2")
+
+	_, err = interceptor(ctx, &todopb.AddTaskRequest{UserId: "2"}, info, handler)
+	assert.NoError(t, err)
+
+	_, err = interceptor(ctx, &todopb.AddTaskRequest{}, info, handler)
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err), "the subject is the user")
+
+	_, err = interceptor(context.Background(), &todopb.CreateProjectRequest{OwnerId: "3"}, info, handler)
+	assert.NoError(t, err)
+
+	ctx = peer.NewContext(context.Background(), &peer.Peer{Addr: &net.TCPAddr{IP: net.IPv4(10, 0, 0, 1), Port: 4000}})
+
+	_, err = interceptor(ctx, &todopb.GetSharedProjectRequest{Token: "t"}, info, handler)
+	assert.NoError(t, err)
+
+	ctx = peer.NewContext(context.Background(), &peer.Peer{Addr: &net.TCPAddr{IP: net.IPv4(10, 0, 0, 1), Port: 4001}})
+
+	_, err = interceptor(ctx, &todopb.GetSharedProjectRequest{Token: "t"}, info, handler)
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err), "anonymous requests are limited per address")
+}
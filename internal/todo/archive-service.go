@@ -0,0 +1,52 @@
+package todo
+
+import (
+	"context"
+
+	"github.com/sladonia/todo-sv/internal/authz"
+	"github.com/sladonia/todo-sv/pkg/todopb"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func (s *service) ArchiveProject(ctx context.Context, r *todopb.ArchiveProjectRequest) (*emptypb.Empty, error) {
+	s.log.Debug("archive project request", zap.Any("request_body", r))
+
+	return s.setArchived(ctx, r, true)
+}
+
+func (s *service) UnarchiveProject(ctx context.Context, r *todopb.ArchiveProjectRequest) (*emptypb.Empty, error) {
+	s.log.Debug("unarchive project request", zap.Any("request_body", r))
+
+	return s.setArchived(ctx, r, false)
+}
+
+func (s *service) setArchived(
+	ctx context.Context,
+	r *todopb.ArchiveProjectRequest,
+	archived bool,
+) (*emptypb.Empty, error) {
+	err := r.ValidateAll()
+	if err != nil {
+		s.log.Debug("archive project invalid request", zap.String("error", err.Error()))
+		return empty(), status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	p, err := s.authorizedProject(ctx, r.ProjectId, r.UserId, authz.ActionArchiveProject)
+	if err != nil {
+		return empty(), err
+	}
+
+	if p.Archived == archived {
+		return empty(), nil
+	}
+
+	err = s.replaceProject(ctx, p, p.WithArchived(archived))
+	if err != nil {
+		return empty(), err
+	}
+
+	return empty(), nil
+}
@@ -0,0 +1,303 @@
+package todo
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/sladonia/todo-sv/internal/authz"
+	"github.com/sladonia/todo-sv/pkg/todopb"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const attachmentChunkSize = 64 * 1024
+
+type AttachmentLimits struct {
+	MaxSize             int64
+	AllowedContentTypes []string
+}
+
+func (l AttachmentLimits) allowsContentType(contentType string) bool {
+	for _, allowed := range l.AllowedContentTypes {
+		if allowed == contentType {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (s *service) UploadAttachment(stream todopb.ToDoService_UploadAttachmentServer) error {
+	ctx := stream.Context()
+
+	r, err := stream.Recv()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return status.Error(codes.InvalidArgument, "upload stream is empty")
+		}
+
+		return err
+	}
+
+	s.log.Debug("upload attachment request", zap.Any("metadata", r.GetMetadata()))
+
+	err = r.ValidateAll()
+	if err != nil {
+		s.log.Debug("upload attachment invalid request", zap.String("error", err.Error()))
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	metadata := r.GetMetadata()
+	if metadata == nil {
+		return status.Error(codes.InvalidArgument, "first upload message must contain attachment metadata")
+	}
+
+	if !s.attachmentLimits.allowsContentType(metadata.ContentType) {
+		return status.Error(
+			codes.InvalidArgument,
+			fmt.Sprintf("content type %s is not allowed", metadata.ContentType),
+		)
+	}
+
+	_, err = s.writableTask(ctx, metadata.ProjectId, metadata.TaskId, metadata.UserId, authz.ActionUploadAttachment)
+	if err != nil {
+		return err
+	}
+
+	attachment := todopb.NewAttachment(metadata)
+	content := &limitedReader{
+		r:     &uploadReader{stream: stream, buf: r.Chunk},
+		limit: s.attachmentLimits.MaxSize,
+	}
+	hash := sha256.New()
+
+	err = s.blobStore.Put(ctx, attachment.Id, io.TeeReader(content, hash))
+	if err != nil {
+		if !errors.Is(err, ErrAttachmentTooLarge) {
+			s.log.Error("failed to store attachment", zap.Error(err))
+		}
+
+		return s.wrapError(err)
+	}
+
+	attachment.Size = content.read
+	attachment.Checksum = hex.EncodeToString(hash.Sum(nil))
+
+	// the project is retrieved and authorized once again since it could have
+	// been changed, archived or left by the user while the content was streamed
+	p, err := s.writableTaskProject(
+		ctx,
+		metadata.ProjectId,
+		metadata.TaskId,
+		metadata.UserId,
+		authz.ActionUploadAttachment,
+	)
+	if err != nil {
+		s.deleteAttachmentBlob(ctx, attachment.Id)
+		return err
+	}
+
+	task, err := projectTask(p, metadata.TaskId)
+	if err != nil {
+		s.deleteAttachmentBlob(ctx, attachment.Id)
+		return err
+	}
+
+	updatedProject := p.WithTask(task.WithAttachment(attachment))
+
+	err = s.storage.Replace(ctx, p, updatedProject)
+	if err != nil {
+		s.deleteAttachmentBlob(ctx, attachment.Id)
+
+		if !IsStorageError(err) {
+			s.log.Error("failed to update project", zap.Error(err))
+		}
+
+		return s.wrapError(err)
+	}
+
+	ev := todopb.NewProjectUpdatedEvent(updatedProject)
+
+	err = s.pubSub.Publish(ctx, todopb.NewProjectSubject(ev.Type.String(), updatedProject.Id), ev)
+	if err != nil {
+		s.log.Error("publish project updated event", zap.Error(err))
+		return s.wrapError(err)
+	}
+
+	return stream.SendAndClose(attachment)
+}
+
+func (s *service) DownloadAttachment(
+	r *todopb.DownloadAttachmentRequest,
+	stream todopb.ToDoService_DownloadAttachmentServer,
+) error {
+	s.log.Debug("download attachment request", zap.Any("request_body", r))
+
+	ctx := stream.Context()
+
+	err := r.ValidateAll()
+	if err != nil {
+		s.log.Debug("download attachment invalid request", zap.String("error", err.Error()))
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	task, err := s.authorizedTask(ctx, r.ProjectId, r.TaskId, r.UserId, authz.ActionViewProject)
+	if err != nil {
+		return err
+	}
+
+	attachment, ok := task.Attachment(r.AttachmentId)
+	if !ok {
+		return status.Error(
+			codes.NotFound,
+			fmt.Sprintf("attachment_id=%s not found in task_id=%s", r.AttachmentId, r.TaskId),
+		)
+	}
+
+	content, err := s.blobStore.Get(ctx, attachment.Id)
+	if err != nil {
+		s.log.Error("failed to retrieve attachment content", zap.Error(err))
+		return s.wrapError(err)
+	}
+	defer content.Close()
+
+	resp := &todopb.DownloadAttachmentResponse{Attachment: attachment}
+	buf := make([]byte, attachmentChunkSize)
+
+	for {
+		n, err := content.Read(buf)
+		if n > 0 {
+			resp.Chunk = buf[:n]
+
+			sendErr := stream.Send(resp)
+			if sendErr != nil {
+				return sendErr
+			}
+
+			resp = &todopb.DownloadAttachmentResponse{}
+		}
+
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		if err != nil {
+			s.log.Error("failed to read attachment content", zap.Error(err))
+			return s.wrapError(err)
+		}
+	}
+
+	// the attachment is empty, the metadata is not sent yet
+	if resp.Attachment != nil {
+		return stream.Send(resp)
+	}
+
+	return nil
+}
+
+// authorizedTask retrieves the task making sure the user may perform the
+// action on it.
+func (s *service) authorizedTask(
+	ctx context.Context,
+	projectID, taskID, userID string,
+	action authz.Action,
+) (*todopb.Task, error) {
+	p, err := s.authorizedTaskProject(ctx, projectID, taskID, userID, action)
+	if err != nil {
+		return nil, err
+	}
+
+	return projectTask(p, taskID)
+}
+
+// writableTask is authorizedTask for the actions that change the task, which
+// archived projects do not accept.
+func (s *service) writableTask(
+	ctx context.Context,
+	projectID, taskID, userID string,
+	action authz.Action,
+) (*todopb.Task, error) {
+	p, err := s.writableTaskProject(ctx, projectID, taskID, userID, action)
+	if err != nil {
+		return nil, err
+	}
+
+	return projectTask(p, taskID)
+}
+
+func projectTask(p *todopb.Project, taskID string) (*todopb.Task, error) {
+	task, ok := p.Tasks[taskID]
+	if !ok {
+		return nil, status.Error(
+			codes.NotFound,
+			fmt.Sprintf("task_id=%s not found in project_id=%s", taskID, p.Id),
+		)
+	}
+
+	return task, nil
+}
+
+func (s *service) deleteAttachmentBlobs(ctx context.Context, tasks ...*todopb.Task) {
+	for _, task := range tasks {
+		for _, attachment := range task.Attachments {
+			s.deleteAttachmentBlob(ctx, attachment.Id)
+		}
+	}
+}
+
+func (s *service) deleteAttachmentBlob(ctx context.Context, attachmentID string) {
+	err := s.blobStore.Delete(ctx, attachmentID)
+	if err != nil {
+		s.log.Error("failed to delete attachment content", zap.String("attachment_id", attachmentID), zap.Error(err))
+	}
+}
+
+// uploadReader exposes the content of the upload stream as io.Reader.
+type uploadReader struct {
+	stream todopb.ToDoService_UploadAttachmentServer
+	buf    []byte
+}
+
+func (r *uploadReader) Read(p []byte) (int, error) {
+	for len(r.buf) == 0 {
+		req, err := r.stream.Recv()
+		if err != nil {
+			return 0, err
+		}
+
+		if req.Metadata != nil {
+			return 0, status.Error(codes.InvalidArgument, "attachment metadata can be sent only once")
+		}
+
+		r.buf = req.Chunk
+	}
+
+	n := copy(p, r.buf)
+	r.buf = r.buf[n:]
+
+	return n, nil
+}
+
+// limitedReader fails with ErrAttachmentTooLarge once more than limit bytes
+// are read.
+type limitedReader struct {
+	r     io.Reader
+	limit int64
+	read  int64
+}
+
+func (r *limitedReader) Read(p []byte) (int, error) {
+	n, err := r.r.Read(p)
+	r.read += int64(n)
+
+	if r.read > r.limit {
+		return n, ErrAttachmentTooLarge
+	}
+
+	return n, err
+}
@@ -0,0 +1,228 @@
+package todo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/sladonia/todo-sv/internal/authz"
+	"github.com/sladonia/todo-sv/pkg/todopb"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+const defaultCommentsPageSize = 50
+
+func (s *service) AddComment(ctx context.Context, r *todopb.AddCommentRequest) (*todopb.Comment, error) {
+	s.log.Debug("add comment request", zap.Any("request_body", r))
+
+	err := r.ValidateAll()
+	if err != nil {
+		s.log.Debug("add comment invalid request", zap.String("error", err.Error()))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	p, err := s.writableTaskProject(ctx, r.ProjectId, r.TaskId, r.UserId, authz.ActionCreateComment)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, ok := p.Tasks[r.TaskId]; !ok {
+		return nil, status.Error(
+			codes.NotFound,
+			fmt.Sprintf("task_id=%s not found in project_id=%s", r.TaskId, r.ProjectId),
+		)
+	}
+
+	comment := todopb.NewComment(r)
+
+	comment.MentionedUserIds, err = s.accessibleMentions(ctx, p, comment.MentionedUserIds)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.commentStorage.Insert(ctx, comment)
+	if err != nil {
+		if !errors.Is(err, ErrAlreadyExists) {
+			s.log.Error("failed to insert comment to db", zap.Error(err))
+		}
+
+		return nil, s.wrapError(err)
+	}
+
+	ev := todopb.NewCommentAddedEvent(p, comment)
+
+	err = s.pubSub.Publish(ctx, todopb.NewProjectSubject(ev.Type.String(), p.Id), ev)
+	if err != nil {
+		s.log.Error("publish comment added event", zap.Error(err))
+		return nil, s.wrapError(err)
+	}
+
+	err = s.notifyMentions(
+		ctx,
+		p,
+		&todopb.Mention{AuthorId: r.UserId, ProjectId: p.Id, TaskId: r.TaskId, CommentId: comment.Id},
+		nil,
+		comment.MentionedUserIds,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return comment, nil
+}
+
+func (s *service) UpdateComment(ctx context.Context, r *todopb.UpdateCommentRequest) (*emptypb.Empty, error) {
+	s.log.Debug("update comment request", zap.Any("request_body", r))
+
+	err := r.ValidateAll()
+	if err != nil {
+		s.log.Debug("update comment invalid request", zap.String("error", err.Error()))
+		return empty(), status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	comment, err := s.authoredComment(ctx, r.CommentId, r.UserId)
+	if err != nil {
+		return empty(), err
+	}
+
+	p, err := s.storage.ByID(ctx, comment.ProjectId)
+	if err != nil {
+		if !errors.Is(err, ErrProjectNotFound) {
+			s.log.Error("failed to retrieve project", zap.Error(err))
+		}
+
+		return empty(), s.wrapError(err)
+	}
+
+	updatedComment := comment.Update(r)
+
+	updatedComment.MentionedUserIds, err = s.accessibleMentions(ctx, p, updatedComment.MentionedUserIds)
+	if err != nil {
+		return empty(), err
+	}
+
+	err = s.commentStorage.Replace(ctx, comment, updatedComment)
+	if err != nil {
+		if !IsStorageError(err) {
+			s.log.Error("failed to replace comment", zap.Error(err))
+		}
+
+		return empty(), s.wrapError(err)
+	}
+
+	err = s.notifyMentions(
+		ctx,
+		p,
+		&todopb.Mention{
+			AuthorId:  r.UserId,
+			ProjectId: p.Id,
+			TaskId:    comment.TaskId,
+			CommentId: comment.Id,
+		},
+		comment.MentionedUserIds,
+		updatedComment.MentionedUserIds,
+	)
+	if err != nil {
+		return empty(), err
+	}
+
+	return empty(), nil
+}
+
+func (s *service) DeleteComment(ctx context.Context, r *todopb.DeleteCommentRequest) (*emptypb.Empty, error) {
+	s.log.Debug("delete comment request", zap.Any("request_body", r))
+
+	err := r.ValidateAll()
+	if err != nil {
+		s.log.Debug("delete comment invalid request", zap.String("error", err.Error()))
+		return empty(), status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	_, err = s.authoredComment(ctx, r.CommentId, r.UserId)
+	if err != nil {
+		return empty(), err
+	}
+
+	err = s.commentStorage.Delete(ctx, r.CommentId)
+	if err != nil {
+		s.log.Error("failed to delete comment", zap.Error(err))
+		return empty(), s.wrapError(err)
+	}
+
+	return empty(), nil
+}
+
+func (s *service) ListComments(
+	ctx context.Context,
+	r *todopb.ListCommentsRequest,
+) (*todopb.ListCommentsResponse, error) {
+	s.log.Debug("list comments request", zap.Any("request_body", r))
+
+	err := r.ValidateAll()
+	if err != nil {
+		s.log.Debug("list comments invalid request", zap.String("error", err.Error()))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	p, err := s.authorizedProject(ctx, r.ProjectId, r.UserId, authz.ActionViewProject)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, ok := p.Tasks[r.TaskId]; !ok {
+		return nil, status.Error(
+			codes.NotFound,
+			fmt.Sprintf("task_id=%s not found in project_id=%s", r.TaskId, r.ProjectId),
+		)
+	}
+
+	pageSize := int64(r.PageSize)
+	if pageSize == 0 {
+		pageSize = defaultCommentsPageSize
+	}
+
+	// One comment more than the page size tells whether there is a next page.
+	comments, err := s.commentStorage.TaskComments(ctx, r.ProjectId, r.TaskId, r.PageToken, pageSize+1)
+	if err != nil {
+		s.log.Error("failed to retrieve comments from storage", zap.Error(err))
+		return nil, s.wrapError(err)
+	}
+
+	var nextPageToken string
+	if int64(len(comments)) > pageSize {
+		comments = comments[:pageSize]
+		nextPageToken = comments[pageSize-1].Id
+	}
+
+	return &todopb.ListCommentsResponse{Comments: comments, NextPageToken: nextPageToken}, nil
+}
+
+// authoredComment retrieves the comment and makes sure the user is its author
+// and may still edit the comments of the project, which must not be archived.
+func (s *service) authoredComment(ctx context.Context, commentID, userID string) (*todopb.Comment, error) {
+	comment, err := s.commentStorage.ByID(ctx, commentID)
+	if err != nil {
+		if !errors.Is(err, ErrCommentNotFound) {
+			s.log.Error("failed to retrieve comment", zap.Error(err))
+		}
+
+		return nil, s.wrapError(err)
+	}
+
+	if !comment.IsAuthor(userID) {
+		return nil, status.Error(
+			codes.PermissionDenied,
+			fmt.Sprintf("user %s is not allowed to modify comment %s", userID, commentID),
+		)
+	}
+
+	_, err = s.writableTaskProject(ctx, comment.ProjectId, comment.TaskId, userID, authz.ActionEditComment)
+	if err != nil {
+		return nil, err
+	}
+
+	return comment, nil
+}
@@ -0,0 +1,59 @@
+package todo
+
+import (
+	"context"
+	"time"
+
+	"github.com/sladonia/todo-sv/pkg/todopb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type CommentStorage interface {
+	ByID(ctx context.Context, commentID string) (*todopb.Comment, error)
+	TaskComments(ctx context.Context, projectID, taskID, afterID string, limit int64) ([]*todopb.Comment, error)
+	Insert(ctx context.Context, comment *todopb.Comment) error
+	Replace(ctx context.Context, prev, curr *todopb.Comment) error
+	Delete(ctx context.Context, commentID string) error
+	DeleteTaskComments(ctx context.Context, projectID, taskID string) error
+	DeleteProjectComments(ctx context.Context, projectID string) error
+}
+
+type CommentBSON struct {
+	ID               string    `bson:"_id"`
+	ProjectID        string    `bson:"project_id"`
+	TaskID           string    `bson:"task_id"`
+	AuthorID         string    `bson:"author_id"`
+	Text             string    `bson:"text"`
+	CreatedAt        time.Time `bson:"created_at"`
+	UpdatedAt        time.Time `bson:"updated_at"`
+	Version          string    `bson:"version"`
+	MentionedUserIDs []string  `bson:"mentioned_user_ids,omitempty"`
+}
+
+func NewCommentBSON(c *todopb.Comment) CommentBSON {
+	return CommentBSON{
+		ID:               c.Id,
+		ProjectID:        c.ProjectId,
+		TaskID:           c.TaskId,
+		AuthorID:         c.AuthorId,
+		Text:             c.Text,
+		CreatedAt:        c.CreatedAt.AsTime(),
+		UpdatedAt:        c.UpdatedAt.AsTime(),
+		Version:          c.Version,
+		MentionedUserIDs: c.MentionedUserIds,
+	}
+}
+
+func (c *CommentBSON) Comment() *todopb.Comment {
+	return &todopb.Comment{
+		Id:               c.ID,
+		ProjectId:        c.ProjectID,
+		TaskId:           c.TaskID,
+		AuthorId:         c.AuthorID,
+		Text:             c.Text,
+		CreatedAt:        timestamppb.New(c.CreatedAt),
+		UpdatedAt:        timestamppb.New(c.UpdatedAt),
+		Version:          c.Version,
+		MentionedUserIds: c.MentionedUserIDs,
+	}
+}
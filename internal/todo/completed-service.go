@@ -0,0 +1,56 @@
+package todo
+
+import (
+	"context"
+
+	"github.com/sladonia/todo-sv/internal/authz"
+	"github.com/sladonia/todo-sv/pkg/todopb"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *service) ListCompletedTasks(
+	ctx context.Context,
+	r *todopb.ListCompletedTasksRequest,
+) (*todopb.ListCompletedTasksResponse, error) {
+	s.log.Debug("list completed tasks request", zap.Any("request_body", r))
+
+	err := r.ValidateAll()
+	if err != nil {
+		s.log.Debug("list completed tasks invalid request", zap.String("error", err.Error()))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	err = validateTimeRange(r.From, r.To)
+	if err != nil {
+		return nil, err
+	}
+
+	var projects []*todopb.Project
+
+	if r.ProjectId != "" {
+		p, err := s.authorizedProject(ctx, r.ProjectId, r.UserId, authz.ActionViewProject)
+		if err != nil {
+			return nil, err
+		}
+
+		projects = append(projects, p)
+	} else {
+		projects, err = s.storage.AllUserProjects(ctx, r.UserId)
+		if err != nil {
+			s.log.Error("failed to retrieve user projects", zap.Error(err))
+			return nil, s.wrapError(err)
+		}
+	}
+
+	var completed []*todopb.CompletedTask
+
+	for _, p := range projects {
+		completed = append(completed, p.CompletedTasks(r.From.AsTime(), r.To.AsTime())...)
+	}
+
+	todopb.SortCompletedTasks(completed)
+
+	return &todopb.ListCompletedTasksResponse{Tasks: completed}, nil
+}
@@ -0,0 +1,236 @@
+package todo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/sladonia/todo-sv/internal/authz"
+	"github.com/sladonia/todo-sv/pkg/todopb"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func (s *service) AddTaskBlocker(ctx context.Context, r *todopb.TaskBlockerRequest) (*emptypb.Empty, error) {
+	s.log.Debug("add task blocker request", zap.Any("request_body", r))
+
+	err := r.ValidateAll()
+	if err != nil {
+		s.log.Debug("add task blocker invalid request", zap.String("error", err.Error()))
+		return empty(), status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	p, err := s.writableTaskProject(ctx, r.ProjectId, r.TaskId, r.UserId, authz.ActionUpdateTask)
+	if err != nil {
+		return empty(), err
+	}
+
+	task, ok := p.Tasks[r.TaskId]
+	if !ok {
+		return empty(), status.Error(
+			codes.NotFound,
+			fmt.Sprintf("task_id=%s not found in project_id=%s", r.TaskId, r.ProjectId),
+		)
+	}
+
+	cache := newProjectCache(s.storage, p)
+
+	blockerProject, err := cache.project(ctx, r.Blocker.ProjectId)
+	if err != nil {
+		if !errors.Is(err, ErrProjectNotFound) {
+			s.log.Error("failed to retrieve project", zap.Error(err))
+		}
+
+		return empty(), s.wrapError(err)
+	}
+
+	err = s.authorize(ctx, r.UserId, authz.ActionViewProject, authz.Resource{Project: blockerProject})
+	if err != nil {
+		return empty(), err
+	}
+
+	if _, ok := blockerProject.Tasks[r.Blocker.TaskId]; !ok {
+		return empty(), status.Error(
+			codes.NotFound,
+			fmt.Sprintf("task_id=%s not found in project_id=%s", r.Blocker.TaskId, r.Blocker.ProjectId),
+		)
+	}
+
+	if task.IsBlockedBy(r.Blocker) {
+		return empty(), nil
+	}
+
+	cycle, err := s.createsCycle(ctx, cache, todopb.NewTaskRef(r.ProjectId, r.TaskId), r.Blocker)
+	if err != nil {
+		return empty(), s.wrapError(err)
+	}
+
+	if cycle {
+		return empty(), status.Error(
+			codes.FailedPrecondition,
+			fmt.Sprintf(
+				"task_id=%s of project_id=%s already depends on task_id=%s",
+				r.Blocker.TaskId, r.Blocker.ProjectId, r.TaskId,
+			),
+		)
+	}
+
+	updatedProject := p.WithTask(task.WithBlocker(r.Blocker))
+
+	return empty(), s.replaceProject(ctx, p, updatedProject)
+}
+
+func (s *service) RemoveTaskBlocker(ctx context.Context, r *todopb.TaskBlockerRequest) (*emptypb.Empty, error) {
+	s.log.Debug("remove task blocker request", zap.Any("request_body", r))
+
+	err := r.ValidateAll()
+	if err != nil {
+		s.log.Debug("remove task blocker invalid request", zap.String("error", err.Error()))
+		return empty(), status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	p, err := s.writableTaskProject(ctx, r.ProjectId, r.TaskId, r.UserId, authz.ActionUpdateTask)
+	if err != nil {
+		return empty(), err
+	}
+
+	task, ok := p.Tasks[r.TaskId]
+	if !ok {
+		return empty(), status.Error(
+			codes.NotFound,
+			fmt.Sprintf("task_id=%s not found in project_id=%s", r.TaskId, r.ProjectId),
+		)
+	}
+
+	if !task.IsBlockedBy(r.Blocker) {
+		return empty(), status.Error(
+			codes.NotFound,
+			fmt.Sprintf("task_id=%s is not blocked by task_id=%s", r.TaskId, r.Blocker.TaskId),
+		)
+	}
+
+	updatedProject := p.WithTask(task.WithoutBlocker(r.Blocker))
+
+	return empty(), s.replaceProject(ctx, p, updatedProject)
+}
+
+// createsCycle reports whether blocking target by blocker closes a loop, that
+// is whether target is reachable from blocker following the blocked_by
+// relations. Projects the user can not access are walked as well, otherwise a
+// cycle could be closed through them.
+func (s *service) createsCycle(ctx context.Context, cache *projectCache, target, blocker *todopb.TaskRef) (bool, error) {
+	visited := make(map[string]bool)
+	queue := []*todopb.TaskRef{blocker}
+
+	for len(queue) > 0 {
+		ref := queue[0]
+		queue = queue[1:]
+
+		if ref.Equal(target) {
+			return true, nil
+		}
+
+		key := ref.ProjectId + "/" + ref.TaskId
+		if visited[key] {
+			continue
+		}
+		visited[key] = true
+
+		task, err := cache.task(ctx, ref)
+		if err != nil {
+			return false, err
+		}
+
+		if task != nil {
+			queue = append(queue, task.BlockedBy...)
+		}
+	}
+
+	return false, nil
+}
+
+// hasOpenBlockers reports whether any of the tasks blocking task is not
+// finished. Blockers that were deleted in the meantime are considered resolved.
+func (s *service) hasOpenBlockers(ctx context.Context, cache *projectCache, task *todopb.Task) (bool, error) {
+	for _, ref := range task.BlockedBy {
+		blocker, err := cache.task(ctx, ref)
+		if err != nil {
+			return false, err
+		}
+
+		if blocker != nil && !blocker.IsFinished {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// markBlockedTasks sets is_blocked on every task of the project that has open
+// blockers.
+func (s *service) markBlockedTasks(ctx context.Context, p *todopb.Project) error {
+	cache := newProjectCache(s.storage, p)
+
+	for _, task := range p.Tasks {
+		blocked, err := s.hasOpenBlockers(ctx, cache, task)
+		if err != nil {
+			return err
+		}
+
+		task.IsBlocked = blocked
+	}
+
+	return nil
+}
+
+// projectCache loads every project at most once while task dependencies are
+// being walked.
+type projectCache struct {
+	storage  Storage
+	projects map[string]*todopb.Project
+}
+
+func newProjectCache(storage Storage, projects ...*todopb.Project) *projectCache {
+	c := &projectCache{
+		storage:  storage,
+		projects: make(map[string]*todopb.Project),
+	}
+
+	for _, p := range projects {
+		c.projects[p.Id] = p
+	}
+
+	return c
+}
+
+func (c *projectCache) project(ctx context.Context, projectID string) (*todopb.Project, error) {
+	if p, ok := c.projects[projectID]; ok {
+		return p, nil
+	}
+
+	p, err := c.storage.ByID(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	c.projects[projectID] = p
+
+	return p, nil
+}
+
+// task returns the referenced task or nil if either the task or its project
+// does not exist anymore.
+func (c *projectCache) task(ctx context.Context, ref *todopb.TaskRef) (*todopb.Task, error) {
+	p, err := c.project(ctx, ref.ProjectId)
+	if err != nil {
+		if errors.Is(err, ErrProjectNotFound) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return p.Tasks[ref.TaskId], nil
+}
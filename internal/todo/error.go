@@ -6,15 +6,34 @@ import (
 )
 
 var (
-	ErrProjectNotFound = errors.New("todo: project not found")
-	ErrVersionMismatch = errors.New("todo: project version mismatch")
-	ErrIDsMismatch     = errors.New("todo: project ids mismatch")
-	ErrAlreadyExists   = errors.New("todo: project already exists")
+	ErrProjectNotFound        = errors.New("todo: project not found")
+	ErrVersionMismatch        = errors.New("todo: project version mismatch")
+	ErrIDsMismatch            = errors.New("todo: project ids mismatch")
+	ErrAlreadyExists          = errors.New("todo: project already exists")
+	ErrCommentNotFound        = errors.New("todo: comment not found")
+	ErrTemplateNotFound       = errors.New("todo: template not found")
+	ErrTagNotFound            = errors.New("todo: tag not found")
+	ErrTimeEntryNotFound      = errors.New("todo: time entry not found")
+	ErrTimerRunning           = errors.New("todo: user already has a running timer")
+	ErrPreferencesNotFound    = errors.New("todo: user preferences not found")
+	ErrServiceAccountNotFound = errors.New("todo: service account not found")
+	ErrInvitationNotFound     = errors.New("todo: invitation not found")
+	ErrShareLinkNotFound      = errors.New("todo: share link not found")
+	ErrTeamNotFound           = errors.New("todo: team not found")
+	ErrBlobNotFound           = errors.New("todo: blob not found")
+	ErrInvalidBlobKey         = errors.New("todo: invalid blob key")
+
+	ErrAttachmentTooLarge = errors.New("todo: attachment exceeds size limit")
 )
 
 func IsStorageError(err error) bool {
 	if errors.Is(err, ErrProjectNotFound) || errors.Is(err, ErrVersionMismatch) ||
-		errors.Is(err, ErrIDsMismatch) || errors.Is(err, ErrAlreadyExists) {
+		errors.Is(err, ErrIDsMismatch) || errors.Is(err, ErrAlreadyExists) ||
+		errors.Is(err, ErrCommentNotFound) || errors.Is(err, ErrTemplateNotFound) ||
+		errors.Is(err, ErrTagNotFound) || errors.Is(err, ErrTimeEntryNotFound) ||
+		errors.Is(err, ErrPreferencesNotFound) || errors.Is(err, ErrServiceAccountNotFound) ||
+		errors.Is(err, ErrInvitationNotFound) || errors.Is(err, ErrShareLinkNotFound) ||
+		errors.Is(err, ErrTeamNotFound) || errors.Is(err, ErrTimerRunning) {
 		return true
 	}
 
@@ -0,0 +1,279 @@
+package todo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/sladonia/todo-sv/internal/authz"
+	"github.com/sladonia/todo-sv/pkg/todopb"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+type InvitationOptions struct {
+	// TTL is the time an invitation can be accepted within.
+	TTL time.Duration
+}
+
+func (s *service) InviteToProject(ctx context.Context, r *todopb.InviteToProjectRequest) (*todopb.Invitation, error) {
+	s.log.Debug("invite to project request", zap.Any("request_body", r))
+
+	err := r.ValidateAll()
+	if err != nil {
+		s.log.Debug("invite to project invalid request", zap.String("error", err.Error()))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if r.Role == todopb.Role_ROLE_OWNER {
+		return nil, status.Error(codes.InvalidArgument, "owner role can not be granted")
+	}
+
+	p, err := s.storage.ByID(ctx, r.ProjectId)
+	if err != nil {
+		if !errors.Is(err, ErrProjectNotFound) {
+			s.log.Error("failed to retrieve project", zap.Error(err))
+		}
+
+		return nil, s.wrapError(err)
+	}
+
+	err = s.authorize(ctx, r.UserId, authz.ActionManageMembers, authz.Resource{Project: p})
+	if err != nil {
+		return nil, err
+	}
+
+	if r.Role == todopb.Role_ROLE_ADMIN {
+		err = s.authorize(ctx, r.UserId, authz.ActionManageAdmins, authz.Resource{Project: p})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if p.IsMember(r.InviteeId) {
+		return nil, status.Error(
+			codes.FailedPrecondition,
+			fmt.Sprintf("user %s is already a member of %s project", r.InviteeId, r.ProjectId),
+		)
+	}
+
+	err = s.checkParticipantQuota(p, 1)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.invite(ctx, p, r.UserId, r.InviteeId, r.Role)
+}
+
+func (s *service) ListInvitations(
+	ctx context.Context,
+	r *todopb.ListInvitationsRequest,
+) (*todopb.ListInvitationsResponse, error) {
+	s.log.Debug("list invitations request", zap.Any("request_body", r))
+
+	err := r.ValidateAll()
+	if err != nil {
+		s.log.Debug("list invitations invalid request", zap.String("error", err.Error()))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	invitations, err := s.invitationStorage.InviteeInvitations(ctx, r.UserId)
+	if err != nil {
+		s.log.Error("failed to retrieve invitations", zap.Error(err))
+		return nil, s.wrapError(err)
+	}
+
+	now := time.Now()
+	active := make([]*todopb.Invitation, 0, len(invitations))
+
+	for _, inv := range invitations {
+		if !inv.IsExpired(now) {
+			active = append(active, inv)
+		}
+	}
+
+	return &todopb.ListInvitationsResponse{Invitations: active}, nil
+}
+
+func (s *service) AcceptInvitation(
+	ctx context.Context,
+	r *todopb.InvitationResponseRequest,
+) (*emptypb.Empty, error) {
+	s.log.Debug("accept invitation request", zap.Any("request_body", r))
+
+	err := r.ValidateAll()
+	if err != nil {
+		s.log.Debug("accept invitation invalid request", zap.String("error", err.Error()))
+		return empty(), status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	inv, err := s.pendingInvitation(ctx, r.InvitationId, r.UserId)
+	if err != nil {
+		return empty(), err
+	}
+
+	p, err := s.storage.ByID(ctx, inv.ProjectId)
+	if err != nil {
+		if !errors.Is(err, ErrProjectNotFound) {
+			s.log.Error("failed to retrieve project", zap.Error(err))
+		}
+
+		return empty(), s.wrapError(err)
+	}
+
+	if !p.IsMember(inv.InviteeId) {
+		err = s.checkParticipantQuota(p, 1)
+		if err != nil {
+			return empty(), err
+		}
+
+		updatedProject := p.WithMemberRole(inv.InviteeId, inv.Role)
+
+		err = s.replaceProject(ctx, p, updatedProject)
+		if err != nil {
+			return empty(), err
+		}
+
+		err = s.propagateParticipants(ctx, updatedProject)
+		if err != nil {
+			return empty(), err
+		}
+	}
+
+	err = s.replaceInvitation(ctx, inv.Respond(todopb.InvitationStatus_INVITATION_ACCEPTED))
+	if err != nil {
+		return empty(), err
+	}
+
+	return empty(), nil
+}
+
+func (s *service) DeclineInvitation(
+	ctx context.Context,
+	r *todopb.InvitationResponseRequest,
+) (*emptypb.Empty, error) {
+	s.log.Debug("decline invitation request", zap.Any("request_body", r))
+
+	err := r.ValidateAll()
+	if err != nil {
+		s.log.Debug("decline invitation invalid request", zap.String("error", err.Error()))
+		return empty(), status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	inv, err := s.pendingInvitation(ctx, r.InvitationId, r.UserId)
+	if err != nil {
+		return empty(), err
+	}
+
+	err = s.replaceInvitation(ctx, inv.Respond(todopb.InvitationStatus_INVITATION_DECLINED))
+	if err != nil {
+		return empty(), err
+	}
+
+	return empty(), nil
+}
+
+// inviteParticipants invites the users that are not members of the project
+// yet with the editor role.
+func (s *service) inviteParticipants(ctx context.Context, p *todopb.Project, inviterID string, userIDs []string) error {
+	for _, userID := range userIDs {
+		if p.IsMember(userID) {
+			continue
+		}
+
+		_, err := s.invite(ctx, p, inviterID, userID, todopb.Role_ROLE_EDITOR)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// invite creates the invitation and delivers it on the invitee's event stream.
+// The pending invitation is returned if the user has already been invited.
+func (s *service) invite(
+	ctx context.Context,
+	p *todopb.Project,
+	inviterID, inviteeID string,
+	role todopb.Role,
+) (*todopb.Invitation, error) {
+	inv, err := s.invitationStorage.PendingInvitation(ctx, p.Id, inviteeID)
+	switch {
+	case err == nil && !inv.IsExpired(time.Now()):
+		return inv, nil
+	case err != nil && !errors.Is(err, ErrInvitationNotFound):
+		s.log.Error("failed to retrieve pending invitation", zap.Error(err))
+		return nil, s.wrapError(err)
+	}
+
+	inv = todopb.NewInvitation(p, inviterID, inviteeID, role, s.invitationOptions.TTL)
+
+	err = s.invitationStorage.Insert(ctx, inv)
+	if err != nil {
+		if !errors.Is(err, ErrAlreadyExists) {
+			s.log.Error("failed to insert invitation", zap.Error(err))
+		}
+
+		return nil, s.wrapError(err)
+	}
+
+	ev := todopb.NewInvitationReceivedEvent(inv)
+
+	err = s.pubSub.Publish(ctx, todopb.NewUserEventsSubject(ev.Type.String(), inviteeID), ev)
+	if err != nil {
+		s.log.Error("publish invitation received event", zap.Error(err))
+		return nil, s.wrapError(err)
+	}
+
+	return inv, nil
+}
+
+// pendingInvitation retrieves the invitation making sure it is addressed to
+// the user and can still be answered.
+func (s *service) pendingInvitation(ctx context.Context, invitationID, userID string) (*todopb.Invitation, error) {
+	inv, err := s.invitationStorage.ByID(ctx, invitationID)
+	if err != nil {
+		if !errors.Is(err, ErrInvitationNotFound) {
+			s.log.Error("failed to retrieve invitation", zap.Error(err))
+		}
+
+		return nil, s.wrapError(err)
+	}
+
+	if inv.InviteeId != userID {
+		return nil, status.Error(
+			codes.PermissionDenied,
+			fmt.Sprintf("invitation %s is not addressed to user %s", invitationID, userID),
+		)
+	}
+
+	if !inv.IsPending() {
+		return nil, status.Error(
+			codes.FailedPrecondition,
+			fmt.Sprintf("invitation %s has already been answered", invitationID),
+		)
+	}
+
+	if inv.IsExpired(time.Now()) {
+		return nil, status.Error(codes.FailedPrecondition, fmt.Sprintf("invitation %s has expired", invitationID))
+	}
+
+	return inv, nil
+}
+
+func (s *service) replaceInvitation(ctx context.Context, inv *todopb.Invitation) error {
+	err := s.invitationStorage.Replace(ctx, inv)
+	if err != nil {
+		if !errors.Is(err, ErrInvitationNotFound) {
+			s.log.Error("failed to replace invitation", zap.Error(err))
+		}
+
+		return s.wrapError(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,63 @@
+package todo
+
+import (
+	"context"
+	"time"
+
+	"github.com/sladonia/todo-sv/pkg/todopb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type InvitationStorage interface {
+	ByID(ctx context.Context, invitationID string) (*todopb.Invitation, error)
+	// PendingInvitation returns the pending invitation of the user to the project.
+	PendingInvitation(ctx context.Context, projectID, inviteeID string) (*todopb.Invitation, error)
+	// InviteeInvitations returns the pending invitations of the user.
+	InviteeInvitations(ctx context.Context, inviteeID string) ([]*todopb.Invitation, error)
+	Insert(ctx context.Context, inv *todopb.Invitation) error
+	Replace(ctx context.Context, inv *todopb.Invitation) error
+	DeleteProjectInvitations(ctx context.Context, projectID string) error
+}
+
+type InvitationBSON struct {
+	ID          string     `bson:"_id"`
+	ProjectID   string     `bson:"project_id"`
+	ProjectName string     `bson:"project_name"`
+	InviterID   string     `bson:"inviter_id"`
+	InviteeID   string     `bson:"invitee_id"`
+	Role        int32      `bson:"role"`
+	Status      int32      `bson:"status"`
+	CreatedAt   time.Time  `bson:"created_at"`
+	ExpiresAt   time.Time  `bson:"expires_at"`
+	RespondedAt *time.Time `bson:"responded_at"`
+}
+
+func NewInvitationBSON(inv *todopb.Invitation) InvitationBSON {
+	return InvitationBSON{
+		ID:          inv.Id,
+		ProjectID:   inv.ProjectId,
+		ProjectName: inv.ProjectName,
+		InviterID:   inv.InviterId,
+		InviteeID:   inv.InviteeId,
+		Role:        int32(inv.Role),
+		Status:      int32(inv.Status),
+		CreatedAt:   inv.CreatedAt.AsTime(),
+		ExpiresAt:   inv.ExpiresAt.AsTime(),
+		RespondedAt: timePtr(inv.RespondedAt),
+	}
+}
+
+func (inv *InvitationBSON) Invitation() *todopb.Invitation {
+	return &todopb.Invitation{
+		Id:          inv.ID,
+		ProjectId:   inv.ProjectID,
+		ProjectName: inv.ProjectName,
+		InviterId:   inv.InviterID,
+		InviteeId:   inv.InviteeID,
+		Role:        todopb.Role(inv.Role),
+		Status:      todopb.InvitationStatus(inv.Status),
+		CreatedAt:   timestamppb.New(inv.CreatedAt),
+		ExpiresAt:   timestamppb.New(inv.ExpiresAt),
+		RespondedAt: timestampPtr(inv.RespondedAt),
+	}
+}
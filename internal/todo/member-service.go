@@ -0,0 +1,244 @@
+package todo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/sladonia/todo-sv/internal/authz"
+	"github.com/sladonia/todo-sv/pkg/todopb"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func (s *service) SetMemberRole(ctx context.Context, r *todopb.SetMemberRoleRequest) (*emptypb.Empty, error) {
+	s.log.Debug("set member role request", zap.Any("request_body", r))
+
+	err := r.ValidateAll()
+	if err != nil {
+		s.log.Debug("set member role invalid request", zap.String("error", err.Error()))
+		return empty(), status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if r.Role == todopb.Role_ROLE_OWNER {
+		return empty(), status.Error(codes.InvalidArgument, "owner role can not be granted")
+	}
+
+	p, err := s.storage.ByID(ctx, r.ProjectId)
+	if err != nil {
+		if !errors.Is(err, ErrProjectNotFound) {
+			s.log.Error("failed to retrieve project", zap.Error(err))
+		}
+
+		return empty(), s.wrapError(err)
+	}
+
+	if p.IsOwner(r.MemberId) {
+		return empty(), status.Error(codes.InvalidArgument, "owner role can not be changed")
+	}
+
+	currentRole, _ := p.RoleOf(r.MemberId)
+	adminChange := r.Role == todopb.Role_ROLE_ADMIN || currentRole == todopb.Role_ROLE_ADMIN
+
+	err = s.authorize(ctx, r.UserId, authz.ActionManageMembers, authz.Resource{Project: p})
+	if err != nil {
+		return empty(), err
+	}
+
+	if adminChange {
+		err = s.authorize(ctx, r.UserId, authz.ActionManageAdmins, authz.Resource{Project: p})
+		if err != nil {
+			return empty(), err
+		}
+	}
+
+	if !p.IsMember(r.MemberId) {
+		return empty(), status.Error(
+			codes.FailedPrecondition,
+			fmt.Sprintf("user %s is not a member of %s project, invite the user instead", r.MemberId, r.ProjectId),
+		)
+	}
+
+	updatedProject := p.WithMemberRole(r.MemberId, r.Role)
+
+	err = s.replaceProject(ctx, p, updatedProject)
+	if err != nil {
+		return empty(), err
+	}
+
+	err = s.propagateParticipants(ctx, updatedProject)
+	if err != nil {
+		return empty(), err
+	}
+
+	return empty(), nil
+}
+
+func (s *service) LeaveProject(ctx context.Context, r *todopb.LeaveProjectRequest) (*emptypb.Empty, error) {
+	s.log.Debug("leave project request", zap.Any("request_body", r))
+
+	err := r.ValidateAll()
+	if err != nil {
+		s.log.Debug("leave project invalid request", zap.String("error", err.Error()))
+		return empty(), status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	p, err := s.storage.ByID(ctx, r.ProjectId)
+	if err != nil {
+		if !errors.Is(err, ErrProjectNotFound) {
+			s.log.Error("failed to retrieve project", zap.Error(err))
+		}
+
+		return empty(), s.wrapError(err)
+	}
+
+	if p.IsOwner(r.UserId) {
+		return empty(), status.Error(
+			codes.FailedPrecondition,
+			fmt.Sprintf("owner can not leave %s project, transfer the ownership first", r.ProjectId),
+		)
+	}
+
+	if !p.IsParticipant(r.UserId) {
+		return empty(), status.Error(
+			codes.FailedPrecondition,
+			fmt.Sprintf("user %s is not a participant of %s project", r.UserId, r.ProjectId),
+		)
+	}
+
+	updatedProject := p.WithoutParticipant(r.UserId)
+
+	err = s.replaceProject(ctx, p, updatedProject)
+	if err != nil {
+		return empty(), err
+	}
+
+	err = s.propagateParticipants(ctx, updatedProject)
+	if err != nil {
+		return empty(), err
+	}
+
+	return empty(), nil
+}
+
+func (s *service) TransferOwnership(
+	ctx context.Context,
+	r *todopb.TransferOwnershipRequest,
+) (*emptypb.Empty, error) {
+	s.log.Debug("transfer ownership request", zap.Any("request_body", r))
+
+	err := r.ValidateAll()
+	if err != nil {
+		s.log.Debug("transfer ownership invalid request", zap.String("error", err.Error()))
+		return empty(), status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	p, err := s.authorizedProject(ctx, r.ProjectId, r.UserId, authz.ActionTransferOwnership)
+	if err != nil {
+		return empty(), err
+	}
+
+	if !p.IsParticipant(r.NewOwnerId) {
+		return empty(), status.Error(
+			codes.FailedPrecondition,
+			fmt.Sprintf("user %s is not a participant of %s project", r.NewOwnerId, r.ProjectId),
+		)
+	}
+
+	return empty(), s.replaceProject(ctx, p, p.WithPendingOwner(r.NewOwnerId))
+}
+
+func (s *service) AcceptOwnership(
+	ctx context.Context,
+	r *todopb.OwnershipTransferRequest,
+) (*emptypb.Empty, error) {
+	s.log.Debug("accept ownership request", zap.Any("request_body", r))
+
+	err := r.ValidateAll()
+	if err != nil {
+		s.log.Debug("accept ownership invalid request", zap.String("error", err.Error()))
+		return empty(), status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	p, err := s.pendingTransferProject(ctx, r.ProjectId)
+	if err != nil {
+		return empty(), err
+	}
+
+	if !p.IsPendingOwner(r.UserId) {
+		return empty(), status.Error(
+			codes.PermissionDenied,
+			fmt.Sprintf("ownership of %s project is not offered to user %s", r.ProjectId, r.UserId),
+		)
+	}
+
+	err = s.checkProjectQuota(ctx, r.UserId)
+	if err != nil {
+		return empty(), err
+	}
+
+	updatedProject := p.WithTransferredOwnership()
+
+	err = s.replaceProject(ctx, p, updatedProject)
+	if err != nil {
+		return empty(), err
+	}
+
+	err = s.propagateParticipants(ctx, updatedProject)
+	if err != nil {
+		return empty(), err
+	}
+
+	return empty(), nil
+}
+
+func (s *service) DeclineOwnership(
+	ctx context.Context,
+	r *todopb.OwnershipTransferRequest,
+) (*emptypb.Empty, error) {
+	s.log.Debug("decline ownership request", zap.Any("request_body", r))
+
+	err := r.ValidateAll()
+	if err != nil {
+		s.log.Debug("decline ownership invalid request", zap.String("error", err.Error()))
+		return empty(), status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	p, err := s.pendingTransferProject(ctx, r.ProjectId)
+	if err != nil {
+		return empty(), err
+	}
+
+	if !p.IsPendingOwner(r.UserId) && !p.IsOwner(r.UserId) {
+		return empty(), status.Error(
+			codes.PermissionDenied,
+			fmt.Sprintf("user %s can not decline the ownership transfer of %s project", r.UserId, r.ProjectId),
+		)
+	}
+
+	return empty(), s.replaceProject(ctx, p, p.WithPendingOwner(""))
+}
+
+// pendingTransferProject retrieves the project making sure its ownership is
+// being transferred.
+func (s *service) pendingTransferProject(ctx context.Context, projectID string) (*todopb.Project, error) {
+	p, err := s.storage.ByID(ctx, projectID)
+	if err != nil {
+		if !errors.Is(err, ErrProjectNotFound) {
+			s.log.Error("failed to retrieve project", zap.Error(err))
+		}
+
+		return nil, s.wrapError(err)
+	}
+
+	if p.PendingOwnerId == "" {
+		return nil, status.Error(
+			codes.FailedPrecondition,
+			fmt.Sprintf("ownership of %s project is not being transferred", projectID),
+		)
+	}
+
+	return p, nil
+}
@@ -0,0 +1,62 @@
+package todo
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/sladonia/todo-sv/pkg/todopb"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type MentionOptions struct {
+	// RejectInaccessible rejects writes mentioning users without access to
+	// the project. Such mentions are dropped otherwise.
+	RejectInaccessible bool
+}
+
+// accessibleMentions returns the mentioned users that have access to the
+// project.
+func (s *service) accessibleMentions(ctx context.Context, p *todopb.Project, mentioned []string) ([]string, error) {
+	var allowed, denied []string
+
+	for _, id := range mentioned {
+		ok, err := s.canView(ctx, id, p)
+		if err != nil {
+			return nil, err
+		}
+
+		if ok {
+			allowed = append(allowed, id)
+		} else {
+			denied = append(denied, id)
+		}
+	}
+
+	if len(denied) > 0 && s.mentionOptions.RejectInaccessible {
+		return nil, status.Error(
+			codes.InvalidArgument,
+			fmt.Sprintf("mentioned users %s have no access to %s project", strings.Join(denied, ", "), p.Id),
+		)
+	}
+
+	return allowed, nil
+}
+
+// notifyMentions publishes a mention event to every user mentioned in curr but
+// not in prev.
+func (s *service) notifyMentions(ctx context.Context, p *todopb.Project, m *todopb.Mention, prev, curr []string) error {
+	for _, mention := range todopb.NewMentions(m, prev, curr) {
+		ev := todopb.NewUserMentionedEvent(p, mention)
+
+		err := s.pubSub.Publish(ctx, todopb.NewUserEventsSubject(ev.Type.String(), mention.UserId), ev)
+		if err != nil {
+			s.log.Error("publish user mentioned event", zap.Error(err))
+			return s.wrapError(err)
+		}
+	}
+
+	return nil
+}
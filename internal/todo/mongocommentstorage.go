@@ -0,0 +1,142 @@
+package todo
+
+import (
+	"context"
+	"errors"
+
+	"github.com/sladonia/todo-sv/pkg/todopb"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type mongoCommentStorage struct {
+	db      *mongo.Database
+	colName string
+}
+
+func NewCommentStorage(db *mongo.Database, colName string) CommentStorage {
+	return &mongoCommentStorage{
+		db:      db,
+		colName: colName,
+	}
+}
+
+func (s *mongoCommentStorage) ByID(ctx context.Context, commentID string) (*todopb.Comment, error) {
+	var commentBSON CommentBSON
+
+	res := s.collection().FindOne(ctx, bson.M{"_id": commentID})
+	if res.Err() != nil {
+		if errors.Is(res.Err(), mongo.ErrNoDocuments) {
+			return nil, ErrCommentNotFound
+		}
+
+		return nil, res.Err()
+	}
+
+	err := res.Decode(&commentBSON)
+	if err != nil {
+		return nil, err
+	}
+
+	return commentBSON.Comment(), nil
+}
+
+// TaskComments returns up to limit comments of the task ordered by creation
+// time. Comment ids are xids, so they sort by creation time and afterID can
+// be used as a pagination cursor.
+func (s *mongoCommentStorage) TaskComments(
+	ctx context.Context,
+	projectID, taskID, afterID string,
+	limit int64,
+) ([]*todopb.Comment, error) {
+	filter := bson.M{
+		"project_id": projectID,
+		"task_id":    taskID,
+	}
+
+	if afterID != "" {
+		filter["_id"] = bson.M{"$gt": afterID}
+	}
+
+	cur, err := s.collection().Find(
+		ctx,
+		filter,
+		options.Find().SetSort(bson.M{"_id": 1}).SetLimit(limit),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	var commentsBSON []CommentBSON
+
+	err = cur.All(ctx, &commentsBSON)
+	if err != nil {
+		return nil, err
+	}
+
+	var comments []*todopb.Comment
+
+	for _, commentBSON := range commentsBSON {
+		comments = append(comments, commentBSON.Comment())
+	}
+
+	return comments, nil
+}
+
+func (s *mongoCommentStorage) Insert(ctx context.Context, comment *todopb.Comment) error {
+	commentBSON := NewCommentBSON(comment)
+
+	_, err := s.collection().InsertOne(ctx, commentBSON)
+	if err != nil {
+		if IsDuplicateKeyError(err) {
+			return ErrAlreadyExists
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+func (s *mongoCommentStorage) Replace(ctx context.Context, prev, curr *todopb.Comment) error {
+	if prev.Id != curr.Id {
+		return ErrIDsMismatch
+	}
+
+	if prev.Version > curr.Version {
+		return ErrVersionMismatch
+	}
+
+	currBSON := NewCommentBSON(curr)
+
+	res := s.collection().FindOneAndReplace(ctx, bson.M{"_id": prev.Id}, currBSON)
+	if res.Err() != nil {
+		if errors.Is(res.Err(), mongo.ErrNoDocuments) {
+			return ErrCommentNotFound
+		}
+
+		return res.Err()
+	}
+
+	return nil
+}
+
+func (s *mongoCommentStorage) Delete(ctx context.Context, commentID string) error {
+	_, err := s.collection().DeleteOne(ctx, bson.M{"_id": commentID})
+	return err
+}
+
+func (s *mongoCommentStorage) DeleteTaskComments(ctx context.Context, projectID, taskID string) error {
+	_, err := s.collection().DeleteMany(ctx, bson.M{"project_id": projectID, "task_id": taskID})
+	return err
+}
+
+func (s *mongoCommentStorage) DeleteProjectComments(ctx context.Context, projectID string) error {
+	_, err := s.collection().DeleteMany(ctx, bson.M{"project_id": projectID})
+	return err
+}
+
+func (s *mongoCommentStorage) collection() *mongo.Collection {
+	return s.db.Collection(s.colName)
+}
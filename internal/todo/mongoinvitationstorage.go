@@ -0,0 +1,122 @@
+package todo
+
+import (
+	"context"
+	"errors"
+
+	"github.com/sladonia/todo-sv/pkg/todopb"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type mongoInvitationStorage struct {
+	db      *mongo.Database
+	colName string
+}
+
+func NewInvitationStorage(db *mongo.Database, colName string) InvitationStorage {
+	return &mongoInvitationStorage{
+		db:      db,
+		colName: colName,
+	}
+}
+
+func (s *mongoInvitationStorage) ByID(ctx context.Context, invitationID string) (*todopb.Invitation, error) {
+	return s.findOne(ctx, bson.M{"_id": invitationID})
+}
+
+func (s *mongoInvitationStorage) PendingInvitation(
+	ctx context.Context,
+	projectID, inviteeID string,
+) (*todopb.Invitation, error) {
+	return s.findOne(ctx, bson.M{
+		"project_id": projectID,
+		"invitee_id": inviteeID,
+		"status":     int32(todopb.InvitationStatus_INVITATION_PENDING),
+	})
+}
+
+func (s *mongoInvitationStorage) InviteeInvitations(ctx context.Context, inviteeID string) ([]*todopb.Invitation, error) {
+	cur, err := s.collection().Find(
+		ctx,
+		bson.M{
+			"invitee_id": inviteeID,
+			"status":     int32(todopb.InvitationStatus_INVITATION_PENDING),
+		},
+		options.Find().SetSort(bson.M{"created_at": 1}),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	var invitationsBSON []InvitationBSON
+
+	err = cur.All(ctx, &invitationsBSON)
+	if err != nil {
+		return nil, err
+	}
+
+	var invitations []*todopb.Invitation
+
+	for _, invitationBSON := range invitationsBSON {
+		invitations = append(invitations, invitationBSON.Invitation())
+	}
+
+	return invitations, nil
+}
+
+func (s *mongoInvitationStorage) Insert(ctx context.Context, inv *todopb.Invitation) error {
+	_, err := s.collection().InsertOne(ctx, NewInvitationBSON(inv))
+	if err != nil {
+		if IsDuplicateKeyError(err) {
+			return ErrAlreadyExists
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+func (s *mongoInvitationStorage) Replace(ctx context.Context, inv *todopb.Invitation) error {
+	res := s.collection().FindOneAndReplace(ctx, bson.M{"_id": inv.Id}, NewInvitationBSON(inv))
+	if res.Err() != nil {
+		if errors.Is(res.Err(), mongo.ErrNoDocuments) {
+			return ErrInvitationNotFound
+		}
+
+		return res.Err()
+	}
+
+	return nil
+}
+
+func (s *mongoInvitationStorage) DeleteProjectInvitations(ctx context.Context, projectID string) error {
+	_, err := s.collection().DeleteMany(ctx, bson.M{"project_id": projectID})
+	return err
+}
+
+func (s *mongoInvitationStorage) findOne(ctx context.Context, filter bson.M) (*todopb.Invitation, error) {
+	var invitationBSON InvitationBSON
+
+	res := s.collection().FindOne(ctx, filter)
+	if res.Err() != nil {
+		if errors.Is(res.Err(), mongo.ErrNoDocuments) {
+			return nil, ErrInvitationNotFound
+		}
+
+		return nil, res.Err()
+	}
+
+	err := res.Decode(&invitationBSON)
+	if err != nil {
+		return nil, err
+	}
+
+	return invitationBSON.Invitation(), nil
+}
+
+func (s *mongoInvitationStorage) collection() *mongo.Collection {
+	return s.db.Collection(s.colName)
+}
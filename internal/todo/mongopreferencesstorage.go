@@ -0,0 +1,58 @@
+package todo
+
+import (
+	"context"
+	"errors"
+
+	"github.com/sladonia/todo-sv/pkg/todopb"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type mongoPreferencesStorage struct {
+	db      *mongo.Database
+	colName string
+}
+
+func NewPreferencesStorage(db *mongo.Database, colName string) PreferencesStorage {
+	return &mongoPreferencesStorage{
+		db:      db,
+		colName: colName,
+	}
+}
+
+func (s *mongoPreferencesStorage) ByUserID(ctx context.Context, userID string) (*todopb.UserPreferences, error) {
+	var prefsBSON UserPreferencesBSON
+
+	res := s.collection().FindOne(ctx, bson.M{"user_id": userID})
+	if res.Err() != nil {
+		if errors.Is(res.Err(), mongo.ErrNoDocuments) {
+			return nil, ErrPreferencesNotFound
+		}
+
+		return nil, res.Err()
+	}
+
+	err := res.Decode(&prefsBSON)
+	if err != nil {
+		return nil, err
+	}
+
+	return prefsBSON.UserPreferences(), nil
+}
+
+func (s *mongoPreferencesStorage) Upsert(ctx context.Context, prefs *todopb.UserPreferences) error {
+	_, err := s.collection().ReplaceOne(
+		ctx,
+		bson.M{"user_id": prefs.UserId},
+		NewUserPreferencesBSON(prefs),
+		options.Replace().SetUpsert(true),
+	)
+
+	return err
+}
+
+func (s *mongoPreferencesStorage) collection() *mongo.Collection {
+	return s.db.Collection(s.colName)
+}
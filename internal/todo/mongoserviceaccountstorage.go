@@ -0,0 +1,170 @@
+package todo
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/sladonia/todo-sv/pkg/todopb"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type mongoServiceAccountStorage struct {
+	db      *mongo.Database
+	colName string
+}
+
+func NewServiceAccountStorage(db *mongo.Database, colName string) ServiceAccountStorage {
+	return &mongoServiceAccountStorage{
+		db:      db,
+		colName: colName,
+	}
+}
+
+func (s *mongoServiceAccountStorage) ByID(ctx context.Context, accountID string) (*todopb.ServiceAccount, error) {
+	accountBSON, err := s.findOne(ctx, bson.M{"_id": accountID})
+	if err != nil {
+		return nil, err
+	}
+
+	return accountBSON.ServiceAccount(), nil
+}
+
+func (s *mongoServiceAccountStorage) ByKeyID(
+	ctx context.Context,
+	keyID string,
+) (*todopb.ServiceAccount, []byte, error) {
+	accountBSON, err := s.findOne(ctx, bson.M{"api_keys.id": keyID})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	for _, key := range accountBSON.ApiKeys {
+		if key.ID == keyID {
+			return accountBSON.ServiceAccount(), key.SecretHash, nil
+		}
+	}
+
+	return nil, nil, ErrServiceAccountNotFound
+}
+
+func (s *mongoServiceAccountStorage) OwnerAccounts(
+	ctx context.Context,
+	ownerID string,
+) ([]*todopb.ServiceAccount, error) {
+	cur, err := s.collection().Find(
+		ctx,
+		bson.M{"owner_id": ownerID},
+		options.Find().SetSort(bson.M{"created_at": 1}),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	var accountsBSON []ServiceAccountBSON
+
+	err = cur.All(ctx, &accountsBSON)
+	if err != nil {
+		return nil, err
+	}
+
+	var accounts []*todopb.ServiceAccount
+
+	for _, accountBSON := range accountsBSON {
+		accounts = append(accounts, accountBSON.ServiceAccount())
+	}
+
+	return accounts, nil
+}
+
+func (s *mongoServiceAccountStorage) Insert(ctx context.Context, account *todopb.ServiceAccount) error {
+	_, err := s.collection().InsertOne(ctx, NewServiceAccountBSON(account))
+	if err != nil {
+		if IsDuplicateKeyError(err) {
+			return ErrAlreadyExists
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+func (s *mongoServiceAccountStorage) AddKey(
+	ctx context.Context,
+	accountID string,
+	key *todopb.ApiKey,
+	secretHash []byte,
+) error {
+	res, err := s.collection().UpdateOne(
+		ctx,
+		bson.M{"_id": accountID},
+		bson.M{"$push": bson.M{"api_keys": NewApiKeyBSON(key, secretHash)}},
+	)
+	if err != nil {
+		return err
+	}
+
+	if res.MatchedCount == 0 {
+		return ErrServiceAccountNotFound
+	}
+
+	return nil
+}
+
+func (s *mongoServiceAccountStorage) RevokeKey(
+	ctx context.Context,
+	accountID, keyID string,
+	revokedAt time.Time,
+) error {
+	res, err := s.collection().UpdateOne(
+		ctx,
+		bson.M{"_id": accountID, "api_keys.id": keyID},
+		bson.M{"$set": bson.M{"api_keys.$.revoked_at": revokedAt}},
+	)
+	if err != nil {
+		return err
+	}
+
+	if res.MatchedCount == 0 {
+		return ErrServiceAccountNotFound
+	}
+
+	return nil
+}
+
+func (s *mongoServiceAccountStorage) TouchKey(ctx context.Context, keyID string, usedAt time.Time) error {
+	_, err := s.collection().UpdateOne(
+		ctx,
+		bson.M{"api_keys.id": keyID},
+		bson.M{"$set": bson.M{"api_keys.$.last_used_at": usedAt}},
+	)
+
+	return err
+}
+
+func (s *mongoServiceAccountStorage) findOne(ctx context.Context, filter bson.M) (*ServiceAccountBSON, error) {
+	var accountBSON ServiceAccountBSON
+
+	res := s.collection().FindOne(ctx, filter)
+	if res.Err() != nil {
+		if errors.Is(res.Err(), mongo.ErrNoDocuments) {
+			return nil, ErrServiceAccountNotFound
+		}
+
+		return nil, res.Err()
+	}
+
+	err := res.Decode(&accountBSON)
+	if err != nil {
+		return nil, err
+	}
+
+	return &accountBSON, nil
+}
+
+func (s *mongoServiceAccountStorage) collection() *mongo.Collection {
+	return s.db.Collection(s.colName)
+}
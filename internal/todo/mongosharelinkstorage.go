@@ -0,0 +1,122 @@
+package todo
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/sladonia/todo-sv/pkg/todopb"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type mongoShareLinkStorage struct {
+	db      *mongo.Database
+	colName string
+}
+
+func NewShareLinkStorage(db *mongo.Database, colName string) ShareLinkStorage {
+	return &mongoShareLinkStorage{
+		db:      db,
+		colName: colName,
+	}
+}
+
+func (s *mongoShareLinkStorage) ByID(ctx context.Context, linkID string) (*todopb.ShareLink, []byte, error) {
+	var linkBSON ShareLinkBSON
+
+	res := s.collection().FindOne(ctx, bson.M{"_id": linkID})
+	if res.Err() != nil {
+		if errors.Is(res.Err(), mongo.ErrNoDocuments) {
+			return nil, nil, ErrShareLinkNotFound
+		}
+
+		return nil, nil, res.Err()
+	}
+
+	err := res.Decode(&linkBSON)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return linkBSON.ShareLink(), linkBSON.SecretHash, nil
+}
+
+func (s *mongoShareLinkStorage) ProjectLinks(ctx context.Context, projectID string) ([]*todopb.ShareLink, error) {
+	cur, err := s.collection().Find(
+		ctx,
+		bson.M{"project_id": projectID},
+		options.Find().SetSort(bson.M{"created_at": 1}),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	var linksBSON []ShareLinkBSON
+
+	err = cur.All(ctx, &linksBSON)
+	if err != nil {
+		return nil, err
+	}
+
+	var links []*todopb.ShareLink
+
+	for _, linkBSON := range linksBSON {
+		links = append(links, linkBSON.ShareLink())
+	}
+
+	return links, nil
+}
+
+func (s *mongoShareLinkStorage) Insert(ctx context.Context, link *todopb.ShareLink, secretHash []byte) error {
+	_, err := s.collection().InsertOne(ctx, NewShareLinkBSON(link, secretHash))
+	if err != nil {
+		if IsDuplicateKeyError(err) {
+			return ErrAlreadyExists
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+func (s *mongoShareLinkStorage) Revoke(ctx context.Context, projectID, linkID string, revokedAt time.Time) error {
+	res, err := s.collection().UpdateOne(
+		ctx,
+		bson.M{"_id": linkID, "project_id": projectID},
+		bson.M{"$set": bson.M{"revoked_at": revokedAt}},
+	)
+	if err != nil {
+		return err
+	}
+
+	if res.MatchedCount == 0 {
+		return ErrShareLinkNotFound
+	}
+
+	return nil
+}
+
+func (s *mongoShareLinkStorage) RecordAccess(ctx context.Context, linkID string, accessedAt time.Time) error {
+	_, err := s.collection().UpdateOne(
+		ctx,
+		bson.M{"_id": linkID},
+		bson.M{
+			"$set": bson.M{"last_accessed_at": accessedAt},
+			"$inc": bson.M{"access_count": 1},
+		},
+	)
+
+	return err
+}
+
+func (s *mongoShareLinkStorage) DeleteProjectLinks(ctx context.Context, projectID string) error {
+	_, err := s.collection().DeleteMany(ctx, bson.M{"project_id": projectID})
+	return err
+}
+
+func (s *mongoShareLinkStorage) collection() *mongo.Collection {
+	return s.db.Collection(s.colName)
+}
@@ -48,6 +48,8 @@ func (s *mongoStorage) AllUserProjects(ctx context.Context, userID string) ([]*t
 			{"$or", bson.A{
 				bson.M{"owner_id": userID},
 				bson.M{"participants": userID},
+				bson.M{"inherited_participants": userID},
+				bson.M{"team_members": userID},
 			}}},
 	)
 	if err != nil {
@@ -70,6 +72,54 @@ func (s *mongoStorage) AllUserProjects(ctx context.Context, userID string) ([]*t
 	return projects, nil
 }
 
+func (s *mongoStorage) Children(ctx context.Context, parentID string) ([]*todopb.Project, error) {
+	cur, err := s.collection().Find(ctx, bson.M{"parent_id": parentID})
+	if err != nil {
+		return nil, err
+	}
+
+	var projectsBSON []ProjectBSON
+
+	err = cur.All(ctx, &projectsBSON)
+	if err != nil {
+		return nil, err
+	}
+
+	var projects []*todopb.Project
+
+	for _, projBSON := range projectsBSON {
+		projects = append(projects, projBSON.Project())
+	}
+
+	return projects, nil
+}
+
+func (s *mongoStorage) TeamProjects(ctx context.Context, teamID string) ([]*todopb.Project, error) {
+	cur, err := s.collection().Find(ctx, bson.M{"team_ids": teamID})
+	if err != nil {
+		return nil, err
+	}
+
+	var projectsBSON []ProjectBSON
+
+	err = cur.All(ctx, &projectsBSON)
+	if err != nil {
+		return nil, err
+	}
+
+	var projects []*todopb.Project
+
+	for _, projBSON := range projectsBSON {
+		projects = append(projects, projBSON.Project())
+	}
+
+	return projects, nil
+}
+
+func (s *mongoStorage) CountOwnerProjects(ctx context.Context, ownerID string) (int64, error) {
+	return s.collection().CountDocuments(ctx, bson.M{"owner_id": ownerID})
+}
+
 func (s *mongoStorage) Insert(ctx context.Context, project *todopb.Project) error {
 	projectBSON := NewProjectBSON(project)
 
@@ -90,16 +140,12 @@ func (s *mongoStorage) Replace(ctx context.Context, prev, curr *todopb.Project)
 		return ErrIDsMismatch
 	}
 
-	if prev.Version > curr.Version {
-		return ErrVersionMismatch
-	}
-
 	curBSON := NewProjectBSON(curr)
 
-	res := s.collection().FindOneAndReplace(ctx, bson.M{"_id": prev.Id}, curBSON)
+	res := s.collection().FindOneAndReplace(ctx, bson.M{"_id": prev.Id, "version": prev.Version}, curBSON)
 	if res.Err() != nil {
 		if errors.Is(res.Err(), mongo.ErrNoDocuments) {
-			return ErrProjectNotFound
+			return s.missingProjectError(ctx, prev.Id)
 		}
 
 		return res.Err()
@@ -108,6 +154,16 @@ func (s *mongoStorage) Replace(ctx context.Context, prev, curr *todopb.Project)
 	return nil
 }
 
+// missingProjectError tells a deleted project from one changed concurrently.
+func (s *mongoStorage) missingProjectError(ctx context.Context, projectID string) error {
+	_, err := s.ByID(ctx, projectID)
+	if err != nil {
+		return err
+	}
+
+	return ErrVersionMismatch
+}
+
 func (s *mongoStorage) Delete(ctx context.Context, projectID string) error {
 	_, err := s.collection().DeleteOne(ctx, bson.M{"_id": projectID})
 	return err
@@ -0,0 +1,89 @@
+package todo
+
+import (
+	"context"
+	"errors"
+
+	"github.com/sladonia/todo-sv/pkg/todopb"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type mongoTagStorage struct {
+	db      *mongo.Database
+	colName string
+}
+
+func NewTagStorage(db *mongo.Database, colName string) TagStorage {
+	return &mongoTagStorage{
+		db:      db,
+		colName: colName,
+	}
+}
+
+func (s *mongoTagStorage) UserTags(ctx context.Context, ownerID string) ([]*todopb.Tag, error) {
+	cur, err := s.collection().Find(
+		ctx,
+		bson.M{"owner_id": ownerID},
+		options.Find().SetSort(bson.M{"name": 1}),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	var tagsBSON []TagBSON
+
+	err = cur.All(ctx, &tagsBSON)
+	if err != nil {
+		return nil, err
+	}
+
+	var tags []*todopb.Tag
+
+	for _, tagBSON := range tagsBSON {
+		tags = append(tags, tagBSON.Tag())
+	}
+
+	return tags, nil
+}
+
+func (s *mongoTagStorage) ByName(ctx context.Context, ownerID, name string) (*todopb.Tag, error) {
+	var tagBSON TagBSON
+
+	res := s.collection().FindOne(ctx, bson.M{"owner_id": ownerID, "name": name})
+	if res.Err() != nil {
+		if errors.Is(res.Err(), mongo.ErrNoDocuments) {
+			return nil, ErrTagNotFound
+		}
+
+		return nil, res.Err()
+	}
+
+	err := res.Decode(&tagBSON)
+	if err != nil {
+		return nil, err
+	}
+
+	return tagBSON.Tag(), nil
+}
+
+func (s *mongoTagStorage) Upsert(ctx context.Context, tag *todopb.Tag) error {
+	_, err := s.collection().ReplaceOne(
+		ctx,
+		bson.M{"owner_id": tag.OwnerId, "name": tag.Name},
+		NewTagBSON(tag),
+		options.Replace().SetUpsert(true),
+	)
+
+	return err
+}
+
+func (s *mongoTagStorage) Delete(ctx context.Context, ownerID, name string) error {
+	_, err := s.collection().DeleteOne(ctx, bson.M{"owner_id": ownerID, "name": name})
+	return err
+}
+
+func (s *mongoTagStorage) collection() *mongo.Collection {
+	return s.db.Collection(s.colName)
+}
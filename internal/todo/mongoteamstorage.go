@@ -0,0 +1,135 @@
+package todo
+
+import (
+	"context"
+	"errors"
+
+	"github.com/sladonia/todo-sv/pkg/todopb"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type mongoTeamStorage struct {
+	db      *mongo.Database
+	colName string
+}
+
+func NewTeamStorage(db *mongo.Database, colName string) TeamStorage {
+	return &mongoTeamStorage{
+		db:      db,
+		colName: colName,
+	}
+}
+
+func (s *mongoTeamStorage) ByID(ctx context.Context, teamID string) (*todopb.Team, error) {
+	var teamBSON TeamBSON
+
+	res := s.collection().FindOne(ctx, bson.M{"_id": teamID})
+	if res.Err() != nil {
+		if errors.Is(res.Err(), mongo.ErrNoDocuments) {
+			return nil, ErrTeamNotFound
+		}
+
+		return nil, res.Err()
+	}
+
+	err := res.Decode(&teamBSON)
+	if err != nil {
+		return nil, err
+	}
+
+	return teamBSON.Team(), nil
+}
+
+func (s *mongoTeamStorage) ByIDs(ctx context.Context, teamIDs []string) ([]*todopb.Team, error) {
+	if len(teamIDs) == 0 {
+		return nil, nil
+	}
+
+	return s.find(ctx, bson.M{"_id": bson.M{"$in": teamIDs}})
+}
+
+func (s *mongoTeamStorage) UserTeams(ctx context.Context, userID string) ([]*todopb.Team, error) {
+	return s.find(ctx, bson.D{
+		{Key: "$or", Value: bson.A{
+			bson.M{"owner_id": userID},
+			bson.M{"members": userID},
+		}},
+	})
+}
+
+func (s *mongoTeamStorage) Insert(ctx context.Context, team *todopb.Team) error {
+	_, err := s.collection().InsertOne(ctx, NewTeamBSON(team))
+	if err != nil {
+		if IsDuplicateKeyError(err) {
+			return ErrAlreadyExists
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+func (s *mongoTeamStorage) Replace(ctx context.Context, prev, curr *todopb.Team) error {
+	if prev.Id != curr.Id {
+		return ErrIDsMismatch
+	}
+
+	res := s.collection().FindOneAndReplace(
+		ctx,
+		bson.M{"_id": prev.Id, "version": prev.Version},
+		NewTeamBSON(curr),
+	)
+	if res.Err() != nil {
+		if errors.Is(res.Err(), mongo.ErrNoDocuments) {
+			return s.missingTeamError(ctx, prev.Id)
+		}
+
+		return res.Err()
+	}
+
+	return nil
+}
+
+func (s *mongoTeamStorage) Delete(ctx context.Context, teamID string) error {
+	_, err := s.collection().DeleteOne(ctx, bson.M{"_id": teamID})
+	return err
+}
+
+// missingTeamError tells a deleted team from one changed concurrently.
+func (s *mongoTeamStorage) missingTeamError(ctx context.Context, teamID string) error {
+	_, err := s.ByID(ctx, teamID)
+	if err != nil {
+		return err
+	}
+
+	return ErrVersionMismatch
+}
+
+func (s *mongoTeamStorage) find(ctx context.Context, filter interface{}) ([]*todopb.Team, error) {
+	cur, err := s.collection().Find(ctx, filter, options.Find().SetSort(bson.M{"_id": 1}))
+	if err != nil {
+		return nil, err
+	}
+
+	var teamsBSON []TeamBSON
+
+	err = cur.All(ctx, &teamsBSON)
+	if err != nil {
+		return nil, err
+	}
+
+	var teams []*todopb.Team
+
+	for _, teamBSON := range teamsBSON {
+		teams = append(teams, teamBSON.Team())
+	}
+
+	return teams, nil
+}
+
+func (s *mongoTeamStorage) collection() *mongo.Collection {
+	return s.db.Collection(s.colName)
+}
@@ -0,0 +1,93 @@
+package todo
+
+import (
+	"context"
+	"errors"
+
+	"github.com/sladonia/todo-sv/pkg/todopb"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type mongoTemplateStorage struct {
+	db      *mongo.Database
+	colName string
+}
+
+func NewTemplateStorage(db *mongo.Database, colName string) TemplateStorage {
+	return &mongoTemplateStorage{
+		db:      db,
+		colName: colName,
+	}
+}
+
+func (s *mongoTemplateStorage) ByID(ctx context.Context, templateID string) (*todopb.Template, error) {
+	var templateBSON TemplateBSON
+
+	res := s.collection().FindOne(ctx, bson.M{"_id": templateID})
+	if res.Err() != nil {
+		if errors.Is(res.Err(), mongo.ErrNoDocuments) {
+			return nil, ErrTemplateNotFound
+		}
+
+		return nil, res.Err()
+	}
+
+	err := res.Decode(&templateBSON)
+	if err != nil {
+		return nil, err
+	}
+
+	return templateBSON.Template(), nil
+}
+
+func (s *mongoTemplateStorage) OwnerTemplates(ctx context.Context, ownerID string) ([]*todopb.Template, error) {
+	cur, err := s.collection().Find(
+		ctx,
+		bson.M{"owner_id": ownerID},
+		options.Find().SetSort(bson.M{"_id": 1}),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	var templatesBSON []TemplateBSON
+
+	err = cur.All(ctx, &templatesBSON)
+	if err != nil {
+		return nil, err
+	}
+
+	var templates []*todopb.Template
+
+	for _, templateBSON := range templatesBSON {
+		templates = append(templates, templateBSON.Template())
+	}
+
+	return templates, nil
+}
+
+func (s *mongoTemplateStorage) Insert(ctx context.Context, template *todopb.Template) error {
+	templateBSON := NewTemplateBSON(template)
+
+	_, err := s.collection().InsertOne(ctx, templateBSON)
+	if err != nil {
+		if IsDuplicateKeyError(err) {
+			return ErrAlreadyExists
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+func (s *mongoTemplateStorage) Delete(ctx context.Context, templateID string) error {
+	_, err := s.collection().DeleteOne(ctx, bson.M{"_id": templateID})
+	return err
+}
+
+func (s *mongoTemplateStorage) collection() *mongo.Collection {
+	return s.db.Collection(s.colName)
+}
@@ -0,0 +1,153 @@
+package todo
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/sladonia/todo-sv/pkg/todopb"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// runningEntryIndex keeps a single running entry per user.
+const runningEntryIndex = "user_id_running"
+
+type mongoTimeEntryStorage struct {
+	db      *mongo.Database
+	colName string
+}
+
+// NewTimeEntryStorage creates the storage along with the index that makes
+// inserting a second running entry of a user fail with ErrTimerRunning.
+func NewTimeEntryStorage(ctx context.Context, db *mongo.Database, colName string) (TimeEntryStorage, error) {
+	s := &mongoTimeEntryStorage{
+		db:      db,
+		colName: colName,
+	}
+
+	_, err := s.collection().Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys: bson.M{"user_id": 1},
+		Options: options.Index().
+			SetName(runningEntryIndex).
+			SetUnique(true).
+			SetPartialFilterExpression(bson.M{"ended_at": bson.M{"$type": "null"}}),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s, nil
+}
+
+func (s *mongoTimeEntryStorage) ByID(ctx context.Context, entryID string) (*todopb.TimeEntry, error) {
+	return s.findOne(ctx, bson.M{"_id": entryID})
+}
+
+func (s *mongoTimeEntryStorage) RunningEntry(ctx context.Context, userID string) (*todopb.TimeEntry, error) {
+	return s.findOne(ctx, bson.M{"user_id": userID, "ended_at": nil})
+}
+
+func (s *mongoTimeEntryStorage) ProjectsEntries(
+	ctx context.Context,
+	projectIDs []string,
+	from, to time.Time,
+) ([]*todopb.TimeEntry, error) {
+	cur, err := s.collection().Find(
+		ctx,
+		bson.M{
+			"project_id": bson.M{"$in": projectIDs},
+			"started_at": bson.M{"$gte": from, "$lt": to},
+		},
+		options.Find().SetSort(bson.M{"started_at": 1}),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	var entriesBSON []TimeEntryBSON
+
+	err = cur.All(ctx, &entriesBSON)
+	if err != nil {
+		return nil, err
+	}
+
+	var entries []*todopb.TimeEntry
+
+	for _, entryBSON := range entriesBSON {
+		entries = append(entries, entryBSON.TimeEntry())
+	}
+
+	return entries, nil
+}
+
+func (s *mongoTimeEntryStorage) Insert(ctx context.Context, entry *todopb.TimeEntry) error {
+	_, err := s.collection().InsertOne(ctx, NewTimeEntryBSON(entry))
+	if err != nil {
+		if IsDuplicateKeyError(err) && strings.Contains(err.Error(), runningEntryIndex) {
+			return ErrTimerRunning
+		}
+
+		if IsDuplicateKeyError(err) {
+			return ErrAlreadyExists
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+func (s *mongoTimeEntryStorage) Replace(ctx context.Context, entry *todopb.TimeEntry) error {
+	res := s.collection().FindOneAndReplace(ctx, bson.M{"_id": entry.Id}, NewTimeEntryBSON(entry))
+	if res.Err() != nil {
+		if errors.Is(res.Err(), mongo.ErrNoDocuments) {
+			return ErrTimeEntryNotFound
+		}
+
+		return res.Err()
+	}
+
+	return nil
+}
+
+func (s *mongoTimeEntryStorage) Delete(ctx context.Context, entryID string) error {
+	_, err := s.collection().DeleteOne(ctx, bson.M{"_id": entryID})
+	return err
+}
+
+func (s *mongoTimeEntryStorage) DeleteTaskEntries(ctx context.Context, projectID, taskID string) error {
+	_, err := s.collection().DeleteMany(ctx, bson.M{"project_id": projectID, "task_id": taskID})
+	return err
+}
+
+func (s *mongoTimeEntryStorage) DeleteProjectEntries(ctx context.Context, projectID string) error {
+	_, err := s.collection().DeleteMany(ctx, bson.M{"project_id": projectID})
+	return err
+}
+
+func (s *mongoTimeEntryStorage) findOne(ctx context.Context, filter bson.M) (*todopb.TimeEntry, error) {
+	var entryBSON TimeEntryBSON
+
+	res := s.collection().FindOne(ctx, filter)
+	if res.Err() != nil {
+		if errors.Is(res.Err(), mongo.ErrNoDocuments) {
+			return nil, ErrTimeEntryNotFound
+		}
+
+		return nil, res.Err()
+	}
+
+	err := res.Decode(&entryBSON)
+	if err != nil {
+		return nil, err
+	}
+
+	return entryBSON.TimeEntry(), nil
+}
+
+func (s *mongoTimeEntryStorage) collection() *mongo.Collection {
+	return s.db.Collection(s.colName)
+}
@@ -0,0 +1,78 @@
+package todo
+
+import (
+	"context"
+	"errors"
+
+	"github.com/sladonia/todo-sv/pkg/todopb"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *service) GetUserPreferences(
+	ctx context.Context,
+	r *todopb.GetUserPreferencesRequest,
+) (*todopb.UserPreferences, error) {
+	s.log.Debug("get user preferences request", zap.Any("request_body", r))
+
+	err := r.ValidateAll()
+	if err != nil {
+		s.log.Debug("get user preferences invalid request", zap.String("error", err.Error()))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return s.userPreferences(ctx, r.UserId)
+}
+
+func (s *service) UpdateUserPreferences(
+	ctx context.Context,
+	r *todopb.UpdateUserPreferencesRequest,
+) (*todopb.UserPreferences, error) {
+	s.log.Debug("update user preferences request", zap.Any("request_body", r))
+
+	err := r.ValidateAll()
+	if err != nil {
+		s.log.Debug("update user preferences invalid request", zap.String("error", err.Error()))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	prefs, err := s.userPreferences(ctx, r.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	updatedPrefs := prefs.Update(r)
+
+	err = s.preferencesStorage.Upsert(ctx, updatedPrefs)
+	if err != nil {
+		s.log.Error("failed to store user preferences", zap.Error(err))
+		return nil, s.wrapError(err)
+	}
+
+	ev := todopb.NewPreferencesUpdatedEvent(updatedPrefs)
+
+	err = s.pubSub.Publish(ctx, todopb.NewUserEventsSubject(ev.Type.String(), r.UserId), ev)
+	if err != nil {
+		s.log.Error("publish preferences updated event", zap.Error(err))
+		return nil, s.wrapError(err)
+	}
+
+	return updatedPrefs, nil
+}
+
+// userPreferences returns the stored preferences of the user or empty ones if
+// the user has never changed them.
+func (s *service) userPreferences(ctx context.Context, userID string) (*todopb.UserPreferences, error) {
+	prefs, err := s.preferencesStorage.ByUserID(ctx, userID)
+	if err != nil {
+		if errors.Is(err, ErrPreferencesNotFound) {
+			return todopb.NewUserPreferences(userID), nil
+		}
+
+		s.log.Error("failed to retrieve user preferences", zap.Error(err))
+		return nil, s.wrapError(err)
+	}
+
+	return prefs, nil
+}
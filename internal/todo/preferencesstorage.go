@@ -0,0 +1,42 @@
+package todo
+
+import (
+	"context"
+	"time"
+
+	"github.com/sladonia/todo-sv/pkg/todopb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type PreferencesStorage interface {
+	ByUserID(ctx context.Context, userID string) (*todopb.UserPreferences, error)
+	Upsert(ctx context.Context, prefs *todopb.UserPreferences) error
+}
+
+type UserPreferencesBSON struct {
+	UserID           string    `bson:"user_id"`
+	PinnedProjectIDs []string  `bson:"pinned_project_ids"`
+	ProjectOrder     []string  `bson:"project_order"`
+	HiddenProjectIDs []string  `bson:"hidden_project_ids"`
+	UpdatedAt        time.Time `bson:"updated_at"`
+}
+
+func NewUserPreferencesBSON(p *todopb.UserPreferences) UserPreferencesBSON {
+	return UserPreferencesBSON{
+		UserID:           p.UserId,
+		PinnedProjectIDs: p.PinnedProjectIds,
+		ProjectOrder:     p.ProjectOrder,
+		HiddenProjectIDs: p.HiddenProjectIds,
+		UpdatedAt:        p.UpdatedAt.AsTime(),
+	}
+}
+
+func (p *UserPreferencesBSON) UserPreferences() *todopb.UserPreferences {
+	return &todopb.UserPreferences{
+		UserId:           p.UserID,
+		PinnedProjectIds: p.PinnedProjectIDs,
+		ProjectOrder:     p.ProjectOrder,
+		HiddenProjectIds: p.HiddenProjectIDs,
+		UpdatedAt:        timestamppb.New(p.UpdatedAt),
+	}
+}
@@ -0,0 +1,39 @@
+package todo
+
+import (
+	"context"
+	"time"
+
+	"github.com/sladonia/todo-sv/internal/authz"
+	"github.com/sladonia/todo-sv/pkg/todopb"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const defaultBurndownDays = 30
+
+func (s *service) GetProjectProgress(
+	ctx context.Context,
+	r *todopb.GetProjectProgressRequest,
+) (*todopb.ProjectProgress, error) {
+	s.log.Debug("get project progress request", zap.Any("request_body", r))
+
+	err := r.ValidateAll()
+	if err != nil {
+		s.log.Debug("get project progress invalid request", zap.String("error", err.Error()))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	p, err := s.authorizedProject(ctx, r.ProjectId, r.UserId, authz.ActionViewProject)
+	if err != nil {
+		return nil, err
+	}
+
+	days := int(r.Days)
+	if days == 0 {
+		days = defaultBurndownDays
+	}
+
+	return todopb.NewProjectProgress(p, time.Now(), days), nil
+}
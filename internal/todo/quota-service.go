@@ -0,0 +1,71 @@
+package todo
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/sladonia/todo-sv/pkg/todopb"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Quotas limit the resources a user can create. Zero disables a quota.
+type Quotas struct {
+	// ProjectsPerOwner limits the projects owned by a user.
+	ProjectsPerOwner int
+	// TasksPerProject limits the tasks of a project.
+	TasksPerProject int
+	// ParticipantsPerProject limits the participants of a project, not
+	// counting the owner and the participants inherited from the parent.
+	// Members of the teams the project is shared with are exempt, as teams
+	// keep changing after the project is shared with them.
+	ParticipantsPerProject int
+}
+
+// checkProjectQuota makes sure the user may own one more project.
+func (s *service) checkProjectQuota(ctx context.Context, ownerID string) error {
+	if s.quotas.ProjectsPerOwner == 0 {
+		return nil
+	}
+
+	count, err := s.storage.CountOwnerProjects(ctx, ownerID)
+	if err != nil {
+		s.log.Error("failed to count owner projects", zap.Error(err))
+		return s.wrapError(err)
+	}
+
+	if count >= int64(s.quotas.ProjectsPerOwner) {
+		return status.Error(
+			codes.ResourceExhausted,
+			fmt.Sprintf("user %s has reached the quota of %d projects", ownerID, s.quotas.ProjectsPerOwner),
+		)
+	}
+
+	return nil
+}
+
+// checkTaskQuota makes sure the project can take the added tasks.
+func (s *service) checkTaskQuota(p *todopb.Project, added int) error {
+	if s.quotas.TasksPerProject == 0 || len(p.Tasks)+added <= s.quotas.TasksPerProject {
+		return nil
+	}
+
+	return status.Error(
+		codes.ResourceExhausted,
+		fmt.Sprintf("project %s has reached the quota of %d tasks", p.Id, s.quotas.TasksPerProject),
+	)
+}
+
+// checkParticipantQuota makes sure the project can take the added
+// participants.
+func (s *service) checkParticipantQuota(p *todopb.Project, added int) error {
+	if s.quotas.ParticipantsPerProject == 0 || len(p.Participants)+added <= s.quotas.ParticipantsPerProject {
+		return nil
+	}
+
+	return status.Error(
+		codes.ResourceExhausted,
+		fmt.Sprintf("project %s has reached the quota of %d participants", p.Id, s.quotas.ParticipantsPerProject),
+	)
+}
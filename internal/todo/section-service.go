@@ -0,0 +1,156 @@
+package todo
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/sladonia/todo-sv/internal/authz"
+	"github.com/sladonia/todo-sv/pkg/todopb"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func (s *service) CreateSection(ctx context.Context, r *todopb.CreateSectionRequest) (*todopb.Section, error) {
+	s.log.Debug("create section request", zap.Any("request_body", r))
+
+	err := r.ValidateAll()
+	if err != nil {
+		s.log.Debug("create section invalid request", zap.String("error", err.Error()))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	p, err := s.writableProject(ctx, r.ProjectId, r.UserId, authz.ActionManageSections)
+	if err != nil {
+		return nil, err
+	}
+
+	section := todopb.NewSection(r.Name)
+
+	err = s.replaceProject(ctx, p, p.WithSection(section))
+	if err != nil {
+		return nil, err
+	}
+
+	return section, nil
+}
+
+func (s *service) RenameSection(ctx context.Context, r *todopb.RenameSectionRequest) (*emptypb.Empty, error) {
+	s.log.Debug("rename section request", zap.Any("request_body", r))
+
+	err := r.ValidateAll()
+	if err != nil {
+		s.log.Debug("rename section invalid request", zap.String("error", err.Error()))
+		return empty(), status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	p, err := s.editableSection(ctx, r.ProjectId, r.SectionId, r.UserId)
+	if err != nil {
+		return empty(), err
+	}
+
+	return empty(), s.replaceProject(ctx, p, p.RenameSection(r.SectionId, r.Name))
+}
+
+func (s *service) MoveSection(ctx context.Context, r *todopb.MoveSectionRequest) (*emptypb.Empty, error) {
+	s.log.Debug("move section request", zap.Any("request_body", r))
+
+	err := r.ValidateAll()
+	if err != nil {
+		s.log.Debug("move section invalid request", zap.String("error", err.Error()))
+		return empty(), status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	p, err := s.editableSection(ctx, r.ProjectId, r.SectionId, r.UserId)
+	if err != nil {
+		return empty(), err
+	}
+
+	return empty(), s.replaceProject(ctx, p, p.MoveSection(r.SectionId, int(r.Position)))
+}
+
+func (s *service) DeleteSection(ctx context.Context, r *todopb.DeleteSectionRequest) (*emptypb.Empty, error) {
+	s.log.Debug("delete section request", zap.Any("request_body", r))
+
+	err := r.ValidateAll()
+	if err != nil {
+		s.log.Debug("delete section invalid request", zap.String("error", err.Error()))
+		return empty(), status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	p, err := s.editableSection(ctx, r.ProjectId, r.SectionId, r.UserId)
+	if err != nil {
+		return empty(), err
+	}
+
+	if r.DeleteTasks {
+		return empty(), s.deleteSectionWithTasks(ctx, p, r.SectionId, r.UserId)
+	}
+
+	if r.MoveToSectionId == r.SectionId || !p.HasSection(r.MoveToSectionId) {
+		return empty(), status.Error(
+			codes.InvalidArgument,
+			fmt.Sprintf("can not move tasks to section_id=%s", r.MoveToSectionId),
+		)
+	}
+
+	return empty(), s.replaceProject(ctx, p, p.WithoutSection(r.SectionId, r.MoveToSectionId))
+}
+
+// deleteSectionWithTasks deletes the section along with its tasks, which the
+// user has to be allowed to delete.
+func (s *service) deleteSectionWithTasks(ctx context.Context, p *todopb.Project, sectionID, userID string) error {
+	tasks := p.SectionTasks(sectionID)
+
+	for _, task := range tasks {
+		err := s.authorize(ctx, userID, authz.ActionDeleteTask, authz.Resource{Project: p, TaskID: task.Id})
+		if err != nil {
+			return err
+		}
+	}
+
+	updatedProject := p.WithoutSection(sectionID, "")
+	for _, task := range tasks {
+		updatedProject = updatedProject.WithoutTask(task.Id)
+	}
+
+	err := s.replaceProject(ctx, p, updatedProject)
+	if err != nil {
+		return err
+	}
+
+	for _, task := range tasks {
+		err = s.commentStorage.DeleteTaskComments(ctx, p.Id, task.Id)
+		if err != nil {
+			s.log.Error("failed to delete task comments", zap.Error(err))
+			return s.wrapError(err)
+		}
+
+		err = s.timeEntryStorage.DeleteTaskEntries(ctx, p.Id, task.Id)
+		if err != nil {
+			s.log.Error("failed to delete task time entries", zap.Error(err))
+			return s.wrapError(err)
+		}
+	}
+
+	s.deleteAttachmentBlobs(ctx, tasks...)
+
+	return nil
+}
+
+func (s *service) editableSection(ctx context.Context, projectID, sectionID, userID string) (*todopb.Project, error) {
+	p, err := s.writableProject(ctx, projectID, userID, authz.ActionManageSections)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, ok := p.Section(sectionID); !ok {
+		return nil, status.Error(
+			codes.NotFound,
+			fmt.Sprintf("section_id=%s not found in project_id=%s", sectionID, projectID),
+		)
+	}
+
+	return p, nil
+}
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/sladonia/todo-sv/internal/authz"
 	"github.com/sladonia/todo-sv/pkg/todopb"
 	"go.uber.org/zap"
 	"google.golang.org/grpc/codes"
@@ -14,16 +15,68 @@ import (
 
 type service struct {
 	todopb.UnimplementedToDoServiceServer
-	storage Storage
-	pubSub  PubSub
-	log     *zap.Logger
+	storage               Storage
+	commentStorage        CommentStorage
+	templateStorage       TemplateStorage
+	tagStorage            TagStorage
+	timeEntryStorage      TimeEntryStorage
+	preferencesStorage    PreferencesStorage
+	serviceAccountStorage ServiceAccountStorage
+	invitationStorage     InvitationStorage
+	shareLinkStorage      ShareLinkStorage
+	teamStorage           TeamStorage
+	blobStore             BlobStore
+	attachmentLimits      AttachmentLimits
+	mentionOptions        MentionOptions
+	invitationOptions     InvitationOptions
+	shareLinkOptions      ShareLinkOptions
+	quotas                Quotas
+	authorizer            authz.Authorizer
+	pubSub                PubSub
+	log                   *zap.Logger
 }
 
-func NewService(log *zap.Logger, storage Storage, pubSub PubSub) todopb.ToDoServiceServer {
+func NewService(
+	log *zap.Logger,
+	storage Storage,
+	commentStorage CommentStorage,
+	templateStorage TemplateStorage,
+	tagStorage TagStorage,
+	timeEntryStorage TimeEntryStorage,
+	preferencesStorage PreferencesStorage,
+	serviceAccountStorage ServiceAccountStorage,
+	invitationStorage InvitationStorage,
+	shareLinkStorage ShareLinkStorage,
+	teamStorage TeamStorage,
+	blobStore BlobStore,
+	attachmentLimits AttachmentLimits,
+	mentionOptions MentionOptions,
+	invitationOptions InvitationOptions,
+	shareLinkOptions ShareLinkOptions,
+	quotas Quotas,
+	authorizer authz.Authorizer,
+	pubSub PubSub,
+) todopb.ToDoServiceServer {
 	return &service{
-		storage: storage,
-		log:     log,
-		pubSub:  pubSub,
+		storage:               storage,
+		commentStorage:        commentStorage,
+		templateStorage:       templateStorage,
+		tagStorage:            tagStorage,
+		timeEntryStorage:      timeEntryStorage,
+		preferencesStorage:    preferencesStorage,
+		serviceAccountStorage: serviceAccountStorage,
+		invitationStorage:     invitationStorage,
+		shareLinkStorage:      shareLinkStorage,
+		teamStorage:           teamStorage,
+		blobStore:             blobStore,
+		attachmentLimits:      attachmentLimits,
+		mentionOptions:        mentionOptions,
+		invitationOptions:     invitationOptions,
+		shareLinkOptions:      shareLinkOptions,
+		quotas:                quotas,
+		authorizer:            authorizer,
+		log:                   log,
+		pubSub:                pubSub,
 	}
 }
 
@@ -38,6 +91,43 @@ func (s *service) CreateProject(ctx context.Context, r *todopb.CreateProjectRequ
 
 	project := todopb.NewProject(r)
 
+	if r.ParentId != "" {
+		parent, err := s.authorizedProject(ctx, r.ParentId, r.OwnerId, authz.ActionAddChildProject)
+		if err != nil {
+			return nil, err
+		}
+
+		project.InheritedParticipants = parent.ChildParticipants()
+		project.InheritedRoles = parent.ChildRoles()
+	}
+
+	err = s.checkProjectQuota(ctx, r.OwnerId)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.checkParticipantQuota(project, len(project.NonMembers(r.Participants)))
+	if err != nil {
+		return nil, err
+	}
+
+	if len(project.TeamIds) > 0 {
+		err = s.authorize(ctx, r.OwnerId, authz.ActionManageMembers, authz.Resource{Project: project})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = s.checkSharedTeams(ctx, r.OwnerId, project.TeamIds)
+	if err != nil {
+		return nil, err
+	}
+
+	project.TeamMembers, err = s.teamsMembers(ctx, project.TeamIds)
+	if err != nil {
+		return nil, err
+	}
+
 	err = s.storage.Insert(ctx, project)
 	if err != nil {
 		if !errors.Is(err, ErrAlreadyExists) {
@@ -55,6 +145,11 @@ func (s *service) CreateProject(ctx context.Context, r *todopb.CreateProjectRequ
 		return nil, s.wrapError(err)
 	}
 
+	err = s.inviteParticipants(ctx, project, r.OwnerId, r.Participants)
+	if err != nil {
+		return nil, err
+	}
+
 	return project, nil
 }
 
@@ -67,22 +162,15 @@ func (s *service) GetProject(ctx context.Context, r *todopb.GetProjectRequest) (
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	p, err := s.storage.ByID(ctx, r.ProjectId)
+	p, err := s.authorizedProject(ctx, r.ProjectId, r.UserId, authz.ActionViewProject)
 	if err != nil {
-		if !errors.Is(err, ErrProjectNotFound) {
-			s.log.Error("failed to retrieve project", zap.Error(err))
-		}
-
-		return nil, s.wrapError(err)
+		return nil, err
 	}
 
-	if !p.CanEdit(r.UserId) {
-		s.log.Debug(
-			"get project. permission denied",
-			zap.String("user_id", r.UserId),
-			zap.String("project_id", r.ProjectId),
-		)
-		return nil, status.Error(codes.PermissionDenied, "user has not get access wrights to the project")
+	err = s.markBlockedTasks(ctx, p)
+	if err != nil {
+		s.log.Error("failed to resolve task blockers", zap.Error(err))
+		return nil, s.wrapError(err)
 	}
 
 	return p, nil
@@ -97,25 +185,69 @@ func (s *service) UpdateProject(ctx context.Context, r *todopb.UpdateProjectRequ
 		return empty(), status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	p, err := s.storage.ByID(ctx, r.ProjectId)
+	p, err := s.authorizedProject(ctx, r.ProjectId, r.UserId, authz.ActionUpdateProject)
 	if err != nil {
-		if !errors.Is(err, ErrProjectNotFound) {
-			s.log.Error("failed to retrieve project", zap.Error(err))
+		return empty(), err
+	}
+
+	if p.ChangesOwner(r) {
+		err = s.authorize(ctx, r.UserId, authz.ActionTransferOwnership, authz.Resource{Project: p})
+		if err != nil {
+			return empty(), err
 		}
 
-		return empty(), s.wrapError(err)
+		return empty(), status.Error(codes.InvalidArgument, "owner can only be changed with TransferOwnership")
 	}
 
-	if !p.IsOwner(r.UserId) {
-		s.log.Debug(
-			"update project. permission denied",
-			zap.String("user_id", r.UserId),
-			zap.String("project_id", r.ProjectId),
+	if sectionID, ok := p.UnknownSection(r.Sections); ok {
+		return empty(), status.Error(
+			codes.InvalidArgument,
+			fmt.Sprintf("section_id=%s not found in project_id=%s", sectionID, r.ProjectId),
 		)
-		return empty(), status.Error(codes.PermissionDenied, "user has not modify access wrights to the project")
+	}
+
+	err = todopb.CheckCustomFieldDefinitions(r.CustomFields)
+	if err != nil {
+		return empty(), status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	err = todopb.CheckStatuses(r.Statuses)
+	if err != nil {
+		return empty(), status.Error(codes.InvalidArgument, err.Error())
 	}
 
 	updatedProject := p.Update(r)
+	invited := p.InvitedParticipants(r)
+
+	err = s.authorizeRemovedParticipants(ctx, r.UserId, p, p.RemovedParticipants(updatedProject))
+	if err != nil {
+		return empty(), err
+	}
+
+	err = s.checkParticipantQuota(updatedProject, len(invited))
+	if err != nil {
+		return empty(), err
+	}
+
+	addedTeams := p.AddedTeams(updatedProject.TeamIds)
+	if len(addedTeams) > 0 {
+		err = s.authorize(ctx, r.UserId, authz.ActionManageMembers, authz.Resource{Project: p})
+		if err != nil {
+			return empty(), err
+		}
+	}
+
+	err = s.checkSharedTeams(ctx, r.UserId, addedTeams)
+	if err != nil {
+		return empty(), err
+	}
+
+	teamMembers, err := s.teamsMembers(ctx, updatedProject.TeamIds)
+	if err != nil {
+		return empty(), err
+	}
+
+	updatedProject, _ = updatedProject.WithTeamMembers(teamMembers)
 
 	err = s.storage.Replace(ctx, p, updatedProject)
 	if err != nil {
@@ -134,9 +266,45 @@ func (s *service) UpdateProject(ctx context.Context, r *todopb.UpdateProjectRequ
 		return nil, s.wrapError(err)
 	}
 
+	err = s.propagateParticipants(ctx, updatedProject)
+	if err != nil {
+		return empty(), err
+	}
+
+	err = s.inviteParticipants(ctx, updatedProject, r.UserId, invited)
+	if err != nil {
+		return empty(), err
+	}
+
 	return empty(), nil
 }
 
+// authorizeRemovedParticipants makes sure the user may remove the
+// participants, removing admins takes the permission to manage them.
+func (s *service) authorizeRemovedParticipants(
+	ctx context.Context,
+	userID string,
+	p *todopb.Project,
+	removed []string,
+) error {
+	if len(removed) == 0 {
+		return nil
+	}
+
+	err := s.authorize(ctx, userID, authz.ActionManageMembers, authz.Resource{Project: p})
+	if err != nil {
+		return err
+	}
+
+	for _, id := range removed {
+		if role, _ := p.RoleOf(id); role == todopb.Role_ROLE_ADMIN {
+			return s.authorize(ctx, userID, authz.ActionManageAdmins, authz.Resource{Project: p})
+		}
+	}
+
+	return nil
+}
+
 func (s *service) AllProjects(ctx context.Context, r *todopb.AllProjectsRequest) (*todopb.AllProjectsResponse, error) {
 	s.log.Debug("all projects request", zap.Any("request_body", r))
 
@@ -152,9 +320,37 @@ func (s *service) AllProjects(ctx context.Context, r *todopb.AllProjectsRequest)
 		return nil, s.wrapError(err)
 	}
 
+	if !r.IncludeArchived {
+		projects = todopb.ActiveProjects(projects)
+	}
+
+	prefs, err := s.userPreferences(ctx, r.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	projects = prefs.Apply(projects, r.IncludeHidden)
+
 	return &todopb.AllProjectsResponse{Projects: projects}, nil
 }
 
+func (s *service) ListTasks(ctx context.Context, r *todopb.ListTasksRequest) (*todopb.ListTasksResponse, error) {
+	s.log.Debug("list tasks request", zap.Any("request_body", r))
+
+	err := r.ValidateAll()
+	if err != nil {
+		s.log.Debug("list tasks invalid request", zap.String("error", err.Error()))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	p, err := s.authorizedProject(ctx, r.ProjectId, r.UserId, authz.ActionViewProject)
+	if err != nil {
+		return nil, err
+	}
+
+	return &todopb.ListTasksResponse{Tasks: p.FilterTasks(r.CustomFields)}, nil
+}
+
 func (s *service) AddTask(ctx context.Context, r *todopb.AddTaskRequest) (*emptypb.Empty, error) {
 	s.log.Debug("add task request", zap.Any("request_body", r))
 
@@ -164,23 +360,35 @@ func (s *service) AddTask(ctx context.Context, r *todopb.AddTaskRequest) (*empty
 		return empty(), status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	p, err := s.storage.ByID(ctx, r.ProjectId)
+	p, err := s.writableProject(ctx, r.ProjectId, r.UserId, authz.ActionCreateTask)
 	if err != nil {
-		if !errors.Is(err, ErrProjectNotFound) {
-			s.log.Error("failed to retrieve project", zap.Error(err))
-		}
+		return empty(), err
+	}
 
-		return empty(), s.wrapError(err)
+	err = s.checkTaskQuota(p, 1)
+	if err != nil {
+		return empty(), err
 	}
 
-	if !p.CanEdit(r.UserId) {
+	if !p.HasSection(r.SectionId) {
 		return empty(), status.Error(
-			codes.PermissionDenied,
-			fmt.Sprintf("user %s has no access to %s project", r.UserId, r.ProjectId),
+			codes.InvalidArgument,
+			fmt.Sprintf("section_id=%s not found in project_id=%s", r.SectionId, r.ProjectId),
 		)
 	}
 
-	task := todopb.NewTask(r)
+	err = s.checkCustomFieldValues(ctx, p, r.CustomFields)
+	if err != nil {
+		return empty(), err
+	}
+
+	task := p.NewTask(r)
+
+	task.MentionedUserIds, err = s.accessibleMentions(ctx, p, task.MentionedUserIds)
+	if err != nil {
+		return empty(), err
+	}
+
 	updatedProject := p.WithTask(task)
 
 	err = s.storage.Replace(ctx, p, updatedProject)
@@ -200,6 +408,17 @@ func (s *service) AddTask(ctx context.Context, r *todopb.AddTaskRequest) (*empty
 		return nil, s.wrapError(err)
 	}
 
+	err = s.notifyMentions(
+		ctx,
+		updatedProject,
+		&todopb.Mention{AuthorId: r.UserId, ProjectId: p.Id, TaskId: task.Id},
+		nil,
+		task.MentionedUserIds,
+	)
+	if err != nil {
+		return empty(), err
+	}
+
 	return empty(), nil
 }
 
@@ -212,20 +431,9 @@ func (s *service) UpdateTask(ctx context.Context, r *todopb.UpdateTaskRequest) (
 		return empty(), status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	p, err := s.storage.ByID(ctx, r.ProjectId)
+	p, err := s.writableTaskProject(ctx, r.ProjectId, r.TaskId, r.UserId, authz.ActionUpdateTask)
 	if err != nil {
-		if !errors.Is(err, ErrProjectNotFound) {
-			s.log.Error("failed to retrieve project", zap.Error(err))
-		}
-
-		return empty(), s.wrapError(err)
-	}
-
-	if !p.CanEdit(r.UserId) {
-		return empty(), status.Error(
-			codes.PermissionDenied,
-			fmt.Sprintf("user %s has no access to %s project", r.UserId, r.ProjectId),
-		)
+		return empty(), err
 	}
 
 	task, ok := p.Tasks[r.TaskId]
@@ -236,7 +444,48 @@ func (s *service) UpdateTask(ctx context.Context, r *todopb.UpdateTaskRequest) (
 		)
 	}
 
-	updatedTask := task.UpdateTask(r)
+	err = s.checkCustomFieldValues(ctx, p, r.CustomFields)
+	if err != nil {
+		return empty(), err
+	}
+
+	updatedTask, err := task.UpdateTask(r, p.Workflow())
+	if err != nil {
+		code := codes.InvalidArgument
+		if errors.Is(err, todopb.ErrTransitionNotAllowed) {
+			code = codes.FailedPrecondition
+		}
+
+		return empty(), status.Error(code, err.Error())
+	}
+
+	if !p.HasSection(updatedTask.SectionId) {
+		return empty(), status.Error(
+			codes.InvalidArgument,
+			fmt.Sprintf("section_id=%s not found in project_id=%s", updatedTask.SectionId, r.ProjectId),
+		)
+	}
+
+	if updatedTask.IsFinished && !task.IsFinished {
+		blocked, err := s.hasOpenBlockers(ctx, newProjectCache(s.storage, p), task)
+		if err != nil {
+			s.log.Error("failed to resolve task blockers", zap.Error(err))
+			return empty(), s.wrapError(err)
+		}
+
+		if blocked {
+			return empty(), status.Error(
+				codes.FailedPrecondition,
+				fmt.Sprintf("task_id=%s has unfinished blockers", r.TaskId),
+			)
+		}
+	}
+
+	updatedTask.MentionedUserIds, err = s.accessibleMentions(ctx, p, updatedTask.MentionedUserIds)
+	if err != nil {
+		return empty(), err
+	}
+
 	updatedProject := p.WithTask(updatedTask)
 
 	err = s.storage.Replace(ctx, p, updatedProject)
@@ -256,6 +505,17 @@ func (s *service) UpdateTask(ctx context.Context, r *todopb.UpdateTaskRequest) (
 		return nil, s.wrapError(err)
 	}
 
+	err = s.notifyMentions(
+		ctx,
+		updatedProject,
+		&todopb.Mention{AuthorId: r.UserId, ProjectId: p.Id, TaskId: task.Id},
+		task.MentionedUserIds,
+		updatedTask.MentionedUserIds,
+	)
+	if err != nil {
+		return empty(), err
+	}
+
 	return empty(), nil
 }
 
@@ -268,20 +528,9 @@ func (s *service) DeleteTask(ctx context.Context, r *todopb.DeleteTaskRequest) (
 		return empty(), status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	p, err := s.storage.ByID(ctx, r.ProjectId)
+	p, err := s.writableTaskProject(ctx, r.ProjectId, r.TaskId, r.UserId, authz.ActionDeleteTask)
 	if err != nil {
-		if !errors.Is(err, ErrProjectNotFound) {
-			s.log.Error("failed to retrieve project", zap.Error(err))
-		}
-
-		return empty(), s.wrapError(err)
-	}
-
-	if !p.CanEdit(r.UserId) {
-		return empty(), status.Error(
-			codes.PermissionDenied,
-			fmt.Sprintf("user %s has no access to %s project", r.UserId, r.ProjectId),
-		)
+		return empty(), err
 	}
 
 	updatedProject := p.WithoutTask(r.TaskId)
@@ -295,6 +544,22 @@ func (s *service) DeleteTask(ctx context.Context, r *todopb.DeleteTaskRequest) (
 		return empty(), s.wrapError(err)
 	}
 
+	err = s.commentStorage.DeleteTaskComments(ctx, r.ProjectId, r.TaskId)
+	if err != nil {
+		s.log.Error("failed to delete task comments", zap.Error(err))
+		return empty(), s.wrapError(err)
+	}
+
+	err = s.timeEntryStorage.DeleteTaskEntries(ctx, r.ProjectId, r.TaskId)
+	if err != nil {
+		s.log.Error("failed to delete task time entries", zap.Error(err))
+		return empty(), s.wrapError(err)
+	}
+
+	if task, ok := p.Tasks[r.TaskId]; ok {
+		s.deleteAttachmentBlobs(ctx, task)
+	}
+
 	ev := todopb.NewProjectUpdatedEvent(updatedProject)
 
 	err = s.pubSub.Publish(ctx, todopb.NewProjectSubject(ev.Type.String(), updatedProject.Id), ev)
@@ -324,10 +589,21 @@ func (s *service) DeleteProject(ctx context.Context, r *todopb.DeleteProjectRequ
 		return empty(), nil
 	}
 
-	if !p.IsOwner(r.UserId) {
+	err = s.authorize(ctx, r.UserId, authz.ActionDeleteProject, authz.Resource{Project: p})
+	if err != nil {
+		return empty(), err
+	}
+
+	children, err := s.storage.Children(ctx, r.ProjectId)
+	if err != nil {
+		s.log.Error("failed to retrieve child projects", zap.Error(err))
+		return empty(), s.wrapError(err)
+	}
+
+	if len(children) > 0 {
 		return empty(), status.Error(
-			codes.PermissionDenied,
-			fmt.Sprintf("user %s has no access to delete %s project", r.UserId, r.ProjectId),
+			codes.FailedPrecondition,
+			fmt.Sprintf("project_id=%s has child projects", r.ProjectId),
 		)
 	}
 
@@ -340,6 +616,32 @@ func (s *service) DeleteProject(ctx context.Context, r *todopb.DeleteProjectRequ
 		return empty(), s.wrapError(err)
 	}
 
+	err = s.commentStorage.DeleteProjectComments(ctx, r.ProjectId)
+	if err != nil {
+		s.log.Error("failed to delete project comments", zap.Error(err))
+		return empty(), s.wrapError(err)
+	}
+
+	err = s.timeEntryStorage.DeleteProjectEntries(ctx, r.ProjectId)
+	if err != nil {
+		s.log.Error("failed to delete project time entries", zap.Error(err))
+		return empty(), s.wrapError(err)
+	}
+
+	err = s.invitationStorage.DeleteProjectInvitations(ctx, r.ProjectId)
+	if err != nil {
+		s.log.Error("failed to delete project invitations", zap.Error(err))
+		return empty(), s.wrapError(err)
+	}
+
+	err = s.shareLinkStorage.DeleteProjectLinks(ctx, r.ProjectId)
+	if err != nil {
+		s.log.Error("failed to delete project share links", zap.Error(err))
+		return empty(), s.wrapError(err)
+	}
+
+	s.deleteAttachmentBlobs(ctx, p.TaskList()...)
+
 	ev := todopb.NewProjectDeletedEvent(p)
 
 	err = s.pubSub.Publish(ctx, todopb.NewProjectSubject(ev.Type.String(), r.ProjectId), ev)
@@ -394,20 +696,197 @@ Loop:
 	return nil
 }
 
+// authorizedProject retrieves the project making sure the user may perform
+// the action on it.
+func (s *service) authorizedProject(
+	ctx context.Context,
+	projectID, userID string,
+	action authz.Action,
+) (*todopb.Project, error) {
+	return s.authorizedTaskProject(ctx, projectID, "", userID, action)
+}
+
+// authorizedTaskProject is authorizedProject for the actions on a single
+// task, the task id is passed on to the authorizer.
+func (s *service) authorizedTaskProject(
+	ctx context.Context,
+	projectID, taskID, userID string,
+	action authz.Action,
+) (*todopb.Project, error) {
+	p, err := s.storage.ByID(ctx, projectID)
+	if err != nil {
+		if !errors.Is(err, ErrProjectNotFound) {
+			s.log.Error("failed to retrieve project", zap.Error(err))
+		}
+
+		return nil, s.wrapError(err)
+	}
+
+	err = s.authorize(ctx, userID, action, authz.Resource{Project: p, TaskID: taskID})
+	if err != nil {
+		return nil, err
+	}
+
+	return p, nil
+}
+
+// authorize asks the authorizer whether the user may perform the action.
+// Denials are returned as PermissionDenied with the reason.
+func (s *service) authorize(ctx context.Context, userID string, action authz.Action, resource authz.Resource) error {
+	err := s.authorizer.Authorize(ctx, userID, action, resource)
+	if err == nil {
+		return nil
+	}
+
+	var denied *authz.DeniedError
+	if errors.As(err, &denied) {
+		s.log.Debug(
+			"permission denied",
+			zap.String("user_id", userID),
+			zap.String("action", string(action)),
+			zap.String("reason", denied.Reason),
+		)
+		return status.Error(codes.PermissionDenied, denied.Reason)
+	}
+
+	s.log.Error("failed to authorize", zap.String("action", string(action)), zap.Error(err))
+	return status.Error(codes.Internal, err.Error())
+}
+
+// canView reports whether the user may view the project.
+func (s *service) canView(ctx context.Context, userID string, p *todopb.Project) (bool, error) {
+	return s.allowed(ctx, userID, authz.ActionViewProject, authz.Resource{Project: p})
+}
+
+// allowed reports whether the user may perform the action, for the requests
+// that skip the resources the user has no access to instead of failing.
+func (s *service) allowed(
+	ctx context.Context,
+	userID string,
+	action authz.Action,
+	resource authz.Resource,
+) (bool, error) {
+	err := s.authorizer.Authorize(ctx, userID, action, resource)
+	if errors.Is(err, authz.ErrDenied) {
+		return false, nil
+	}
+
+	if err != nil {
+		s.log.Error("failed to authorize", zap.String("action", string(action)), zap.Error(err))
+		return false, status.Error(codes.Internal, err.Error())
+	}
+
+	return true, nil
+}
+
+// checkCustomFieldValues makes sure the values match the project custom
+// fields and the users set as values may view the project.
+func (s *service) checkCustomFieldValues(
+	ctx context.Context,
+	p *todopb.Project,
+	values map[string]*todopb.CustomFieldValue,
+) error {
+	err := p.CheckCustomFieldValues(values)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	for _, userID := range p.CustomFieldUsers(values) {
+		ok, err := s.canView(ctx, userID, p)
+		if err != nil {
+			return err
+		}
+
+		if !ok {
+			return status.Error(
+				codes.InvalidArgument,
+				fmt.Sprintf("user %s set as a custom field value has no access to %s project", userID, p.Id),
+			)
+		}
+	}
+
+	return nil
+}
+
+// writableProject is authorizedProject for requests that change tasks, which
+// archived projects do not accept.
+func (s *service) writableProject(
+	ctx context.Context,
+	projectID, userID string,
+	action authz.Action,
+) (*todopb.Project, error) {
+	return s.writableTaskProject(ctx, projectID, "", userID, action)
+}
+
+// writableTaskProject is writableProject for the actions on a single task.
+func (s *service) writableTaskProject(
+	ctx context.Context,
+	projectID, taskID, userID string,
+	action authz.Action,
+) (*todopb.Project, error) {
+	p, err := s.authorizedTaskProject(ctx, projectID, taskID, userID, action)
+	if err != nil {
+		return nil, err
+	}
+
+	if p.Archived {
+		return nil, status.Error(
+			codes.FailedPrecondition,
+			fmt.Sprintf("project_id=%s is archived", projectID),
+		)
+	}
+
+	return p, nil
+}
+
+// replaceProject stores the updated project and notifies the participants.
+func (s *service) replaceProject(ctx context.Context, prev, curr *todopb.Project) error {
+	err := s.storage.Replace(ctx, prev, curr)
+	if err != nil {
+		if !IsStorageError(err) {
+			s.log.Error("failed to update project", zap.Error(err))
+		}
+
+		return s.wrapError(err)
+	}
+
+	ev := todopb.NewProjectUpdatedEvent(curr)
+
+	err = s.pubSub.Publish(ctx, todopb.NewProjectSubject(ev.Type.String(), curr.Id), ev)
+	if err != nil {
+		s.log.Error("publish project updated event", zap.Error(err))
+		return s.wrapError(err)
+	}
+
+	return nil
+}
+
 func (s *service) wrapError(err error) error {
 	if err == nil {
 		return nil
 	}
 
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+
 	switch {
-	case errors.Is(err, ErrProjectNotFound):
+	case errors.Is(err, ErrProjectNotFound), errors.Is(err, ErrCommentNotFound), errors.Is(err, ErrBlobNotFound),
+		errors.Is(err, ErrTemplateNotFound), errors.Is(err, ErrTagNotFound),
+		errors.Is(err, ErrTimeEntryNotFound), errors.Is(err, ErrServiceAccountNotFound),
+		errors.Is(err, ErrInvitationNotFound), errors.Is(err, ErrShareLinkNotFound),
+		errors.Is(err, ErrTeamNotFound):
 		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, ErrAttachmentTooLarge):
+		return status.Error(codes.ResourceExhausted, err.Error())
 	case errors.Is(err, ErrAlreadyExists):
 		return status.Error(codes.AlreadyExists, err.Error())
 	case errors.Is(err, ErrIDsMismatch):
 		return status.Error(codes.InvalidArgument, err.Error())
 	case errors.Is(err, ErrVersionMismatch):
 		return status.Error(codes.Aborted, err.Error())
+	case errors.Is(err, ErrTimerRunning):
+		return status.Error(codes.FailedPrecondition, err.Error())
 	}
 
 	return status.Error(codes.Internal, err.Error())
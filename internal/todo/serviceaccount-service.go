@@ -0,0 +1,205 @@
+package todo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/sladonia/todo-sv/internal/auth"
+	"github.com/sladonia/todo-sv/pkg/todopb"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// apiKeyTouchInterval limits how often the last used time of a key is
+// written.
+const apiKeyTouchInterval = time.Minute
+
+func (s *service) CreateServiceAccount(
+	ctx context.Context,
+	r *todopb.CreateServiceAccountRequest,
+) (*todopb.ServiceAccount, error) {
+	s.log.Debug("create service account request", zap.Any("request_body", r))
+
+	err := r.ValidateAll()
+	if err != nil {
+		s.log.Debug("create service account invalid request", zap.String("error", err.Error()))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	account := todopb.NewServiceAccount(r)
+
+	err = s.serviceAccountStorage.Insert(ctx, account)
+	if err != nil {
+		if !IsStorageError(err) {
+			s.log.Error("failed to insert service account", zap.Error(err))
+		}
+
+		return nil, s.wrapError(err)
+	}
+
+	return account, nil
+}
+
+func (s *service) ListServiceAccounts(
+	ctx context.Context,
+	r *todopb.ListServiceAccountsRequest,
+) (*todopb.ListServiceAccountsResponse, error) {
+	s.log.Debug("list service accounts request", zap.Any("request_body", r))
+
+	err := r.ValidateAll()
+	if err != nil {
+		s.log.Debug("list service accounts invalid request", zap.String("error", err.Error()))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	accounts, err := s.serviceAccountStorage.OwnerAccounts(ctx, r.UserId)
+	if err != nil {
+		s.log.Error("failed to retrieve service accounts", zap.Error(err))
+		return nil, s.wrapError(err)
+	}
+
+	return &todopb.ListServiceAccountsResponse{ServiceAccounts: accounts}, nil
+}
+
+func (s *service) CreateApiKey(ctx context.Context, r *todopb.CreateApiKeyRequest) (*todopb.CreateApiKeyResponse, error) {
+	s.log.Debug("create api key request", zap.Any("request_body", r))
+
+	err := r.ValidateAll()
+	if err != nil {
+		s.log.Debug("create api key invalid request", zap.String("error", err.Error()))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	_, err = s.ownServiceAccount(ctx, r.ServiceAccountId, r.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	apiKey, key, secretHash, err := todopb.NewApiKey(r)
+	if err != nil {
+		s.log.Error("failed to generate api key", zap.Error(err))
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	err = s.serviceAccountStorage.AddKey(ctx, r.ServiceAccountId, apiKey, secretHash)
+	if err != nil {
+		if !IsStorageError(err) {
+			s.log.Error("failed to store api key", zap.Error(err))
+		}
+
+		return nil, s.wrapError(err)
+	}
+
+	return &todopb.CreateApiKeyResponse{ApiKey: apiKey, Key: key}, nil
+}
+
+func (s *service) RevokeApiKey(ctx context.Context, r *todopb.RevokeApiKeyRequest) (*emptypb.Empty, error) {
+	s.log.Debug("revoke api key request", zap.Any("request_body", r))
+
+	err := r.ValidateAll()
+	if err != nil {
+		s.log.Debug("revoke api key invalid request", zap.String("error", err.Error()))
+		return empty(), status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	account, err := s.ownServiceAccount(ctx, r.ServiceAccountId, r.UserId)
+	if err != nil {
+		return empty(), err
+	}
+
+	apiKey, ok := account.ApiKey(r.ApiKeyId)
+	if !ok {
+		return empty(), status.Error(
+			codes.NotFound,
+			fmt.Sprintf("api_key_id=%s not found in service_account_id=%s", r.ApiKeyId, r.ServiceAccountId),
+		)
+	}
+
+	if apiKey.IsRevoked() {
+		return empty(), nil
+	}
+
+	err = s.serviceAccountStorage.RevokeKey(ctx, r.ServiceAccountId, r.ApiKeyId, time.Now())
+	if err != nil {
+		if !IsStorageError(err) {
+			s.log.Error("failed to revoke api key", zap.Error(err))
+		}
+
+		return empty(), s.wrapError(err)
+	}
+
+	return empty(), nil
+}
+
+func (s *service) ownServiceAccount(ctx context.Context, accountID, userID string) (*todopb.ServiceAccount, error) {
+	account, err := s.serviceAccountStorage.ByID(ctx, accountID)
+	if err != nil {
+		if !errors.Is(err, ErrServiceAccountNotFound) {
+			s.log.Error("failed to retrieve service account", zap.Error(err))
+		}
+
+		return nil, s.wrapError(err)
+	}
+
+	if !account.IsOwner(userID) {
+		return nil, status.Error(
+			codes.PermissionDenied,
+			fmt.Sprintf("user %s has no access to %s service account", userID, accountID),
+		)
+	}
+
+	return account, nil
+}
+
+// APIKeyAuthenticator authenticates the API keys of the service accounts.
+type APIKeyAuthenticator struct {
+	storage ServiceAccountStorage
+	log     *zap.Logger
+}
+
+func NewAPIKeyAuthenticator(storage ServiceAccountStorage, log *zap.Logger) *APIKeyAuthenticator {
+	return &APIKeyAuthenticator{
+		storage: storage,
+		log:     log,
+	}
+}
+
+func (a *APIKeyAuthenticator) AuthenticateKey(ctx context.Context, key string) (*auth.KeyIdentity, error) {
+	keyID, secret, ok := todopb.ParseApiKey(key)
+	if !ok {
+		return nil, auth.ErrInvalidAPIKey
+	}
+
+	account, secretHash, err := a.storage.ByKeyID(ctx, keyID)
+	if err != nil {
+		if errors.Is(err, ErrServiceAccountNotFound) {
+			return nil, auth.ErrInvalidAPIKey
+		}
+
+		return nil, err
+	}
+
+	apiKey, ok := account.ApiKey(keyID)
+	if !ok || apiKey.IsRevoked() || !todopb.ApiKeySecretMatches(secret, secretHash) {
+		return nil, auth.ErrInvalidAPIKey
+	}
+
+	now := time.Now()
+
+	if apiKey.LastUsedAt == nil || now.Sub(apiKey.LastUsedAt.AsTime()) >= apiKeyTouchInterval {
+		err = a.storage.TouchKey(ctx, keyID, now)
+		if err != nil {
+			a.log.Error("failed to record api key usage", zap.Error(err))
+		}
+	}
+
+	return &auth.KeyIdentity{
+		Subject:    account.Id,
+		ProjectIDs: apiKey.GetScope().GetProjectIds(),
+		Operations: apiKey.GetScope().GetOperations(),
+	}, nil
+}
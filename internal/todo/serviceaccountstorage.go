@@ -0,0 +1,92 @@
+package todo
+
+import (
+	"context"
+	"time"
+
+	"github.com/sladonia/todo-sv/pkg/todopb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type ServiceAccountStorage interface {
+	ByID(ctx context.Context, accountID string) (*todopb.ServiceAccount, error)
+	// ByKeyID returns the account owning the API key and the hash of the key
+	// secret.
+	ByKeyID(ctx context.Context, keyID string) (*todopb.ServiceAccount, []byte, error)
+	OwnerAccounts(ctx context.Context, ownerID string) ([]*todopb.ServiceAccount, error)
+	Insert(ctx context.Context, account *todopb.ServiceAccount) error
+	AddKey(ctx context.Context, accountID string, key *todopb.ApiKey, secretHash []byte) error
+	RevokeKey(ctx context.Context, accountID, keyID string, revokedAt time.Time) error
+	TouchKey(ctx context.Context, keyID string, usedAt time.Time) error
+}
+
+type ServiceAccountBSON struct {
+	ID        string       `bson:"_id"`
+	OwnerID   string       `bson:"owner_id"`
+	Name      string       `bson:"name"`
+	CreatedAt time.Time    `bson:"created_at"`
+	ApiKeys   []ApiKeyBSON `bson:"api_keys"`
+}
+
+type ApiKeyBSON struct {
+	ID         string     `bson:"id"`
+	Name       string     `bson:"name"`
+	SecretHash []byte     `bson:"secret_hash"`
+	ProjectIDs []string   `bson:"project_ids"`
+	Operations []string   `bson:"operations"`
+	CreatedAt  time.Time  `bson:"created_at"`
+	LastUsedAt *time.Time `bson:"last_used_at"`
+	RevokedAt  *time.Time `bson:"revoked_at"`
+}
+
+func NewServiceAccountBSON(a *todopb.ServiceAccount) ServiceAccountBSON {
+	return ServiceAccountBSON{
+		ID:        a.Id,
+		OwnerID:   a.OwnerId,
+		Name:      a.Name,
+		CreatedAt: a.CreatedAt.AsTime(),
+		ApiKeys:   []ApiKeyBSON{},
+	}
+}
+
+func NewApiKeyBSON(k *todopb.ApiKey, secretHash []byte) ApiKeyBSON {
+	return ApiKeyBSON{
+		ID:         k.Id,
+		Name:       k.Name,
+		SecretHash: secretHash,
+		ProjectIDs: k.GetScope().GetProjectIds(),
+		Operations: k.GetScope().GetOperations(),
+		CreatedAt:  k.CreatedAt.AsTime(),
+		LastUsedAt: timePtr(k.LastUsedAt),
+		RevokedAt:  timePtr(k.RevokedAt),
+	}
+}
+
+func (a *ServiceAccountBSON) ServiceAccount() *todopb.ServiceAccount {
+	account := &todopb.ServiceAccount{
+		Id:        a.ID,
+		OwnerId:   a.OwnerID,
+		Name:      a.Name,
+		CreatedAt: timestamppb.New(a.CreatedAt),
+	}
+
+	for _, k := range a.ApiKeys {
+		account.ApiKeys = append(account.ApiKeys, k.ApiKey())
+	}
+
+	return account
+}
+
+func (k *ApiKeyBSON) ApiKey() *todopb.ApiKey {
+	return &todopb.ApiKey{
+		Id:   k.ID,
+		Name: k.Name,
+		Scope: &todopb.ApiKeyScope{
+			ProjectIds: k.ProjectIDs,
+			Operations: k.Operations,
+		},
+		CreatedAt:  timestamppb.New(k.CreatedAt),
+		LastUsedAt: timestampPtr(k.LastUsedAt),
+		RevokedAt:  timestampPtr(k.RevokedAt),
+	}
+}
@@ -0,0 +1,79 @@
+package todo
+
+import (
+	"net"
+	"net/http"
+	"strings"
+
+	"github.com/sladonia/todo-sv/pkg/todopb"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// SharePathPrefix is the path the shared projects are served under, followed
+// by the share token.
+const SharePathPrefix = "/shared/"
+
+type shareHandler struct {
+	service todopb.ToDoServiceServer
+	log     *zap.Logger
+}
+
+// NewShareHandler serves the shared projects as JSON at GET /shared/{token}.
+func NewShareHandler(service todopb.ToDoServiceServer, log *zap.Logger) http.Handler {
+	return &shareHandler{
+		service: service,
+		log:     log,
+	}
+}
+
+func (h *shareHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		w.Header().Set("Allow", http.MethodGet)
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+
+	ctx := r.Context()
+	if addr, err := net.ResolveTCPAddr("tcp", r.RemoteAddr); err == nil {
+		ctx = peer.NewContext(ctx, &peer.Peer{Addr: addr})
+	}
+
+	shared, err := h.service.GetSharedProject(ctx, &todopb.GetSharedProjectRequest{
+		Token: strings.TrimPrefix(r.URL.Path, SharePathPrefix),
+	})
+	if err != nil {
+		st := status.Convert(err)
+		http.Error(w, st.Message(), httpStatus(st.Code()))
+		return
+	}
+
+	body, err := protojson.Marshal(shared)
+	if err != nil {
+		h.log.Error("failed to marshal shared project", zap.Error(err))
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+
+	_, err = w.Write(body)
+	if err != nil {
+		h.log.Debug("failed to write shared project", zap.Error(err))
+	}
+}
+
+func httpStatus(code codes.Code) int {
+	switch code {
+	case codes.InvalidArgument:
+		return http.StatusBadRequest
+	case codes.NotFound:
+		return http.StatusNotFound
+	}
+
+	return http.StatusInternalServerError
+}
@@ -0,0 +1,190 @@
+package todo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/sladonia/todo-sv/internal/authz"
+	"github.com/sladonia/todo-sv/pkg/todopb"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+type ShareLinkOptions struct {
+	// DefaultTTL is used for the links created without a ttl.
+	DefaultTTL time.Duration
+	// MaxTTL limits the ttl of the links.
+	MaxTTL time.Duration
+}
+
+func (s *service) CreateShareLink(
+	ctx context.Context,
+	r *todopb.CreateShareLinkRequest,
+) (*todopb.CreateShareLinkResponse, error) {
+	s.log.Debug("create share link request", zap.Any("request_body", r))
+
+	err := r.ValidateAll()
+	if err != nil {
+		s.log.Debug("create share link invalid request", zap.String("error", err.Error()))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ttl := s.shareLinkOptions.DefaultTTL
+	if r.Ttl != nil {
+		ttl = r.Ttl.AsDuration()
+	}
+
+	if ttl <= 0 || ttl > s.shareLinkOptions.MaxTTL {
+		return nil, status.Error(
+			codes.InvalidArgument,
+			fmt.Sprintf("share link ttl must be positive and not exceed %s", s.shareLinkOptions.MaxTTL),
+		)
+	}
+
+	_, err = s.authorizedProject(ctx, r.ProjectId, r.UserId, authz.ActionShareProject)
+	if err != nil {
+		return nil, err
+	}
+
+	link, token, secretHash, err := todopb.NewShareLink(r, ttl)
+	if err != nil {
+		s.log.Error("failed to generate share link", zap.Error(err))
+		return nil, s.wrapError(err)
+	}
+
+	err = s.shareLinkStorage.Insert(ctx, link, secretHash)
+	if err != nil {
+		if !IsStorageError(err) {
+			s.log.Error("failed to insert share link", zap.Error(err))
+		}
+
+		return nil, s.wrapError(err)
+	}
+
+	return &todopb.CreateShareLinkResponse{ShareLink: link, Token: token}, nil
+}
+
+func (s *service) ListShareLinks(
+	ctx context.Context,
+	r *todopb.ListShareLinksRequest,
+) (*todopb.ListShareLinksResponse, error) {
+	s.log.Debug("list share links request", zap.Any("request_body", r))
+
+	err := r.ValidateAll()
+	if err != nil {
+		s.log.Debug("list share links invalid request", zap.String("error", err.Error()))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	_, err = s.authorizedProject(ctx, r.ProjectId, r.UserId, authz.ActionShareProject)
+	if err != nil {
+		return nil, err
+	}
+
+	links, err := s.shareLinkStorage.ProjectLinks(ctx, r.ProjectId)
+	if err != nil {
+		s.log.Error("failed to retrieve share links", zap.Error(err))
+		return nil, s.wrapError(err)
+	}
+
+	return &todopb.ListShareLinksResponse{ShareLinks: links}, nil
+}
+
+func (s *service) RevokeShareLink(ctx context.Context, r *todopb.RevokeShareLinkRequest) (*emptypb.Empty, error) {
+	s.log.Debug("revoke share link request", zap.Any("request_body", r))
+
+	err := r.ValidateAll()
+	if err != nil {
+		s.log.Debug("revoke share link invalid request", zap.String("error", err.Error()))
+		return empty(), status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	_, err = s.authorizedProject(ctx, r.ProjectId, r.UserId, authz.ActionShareProject)
+	if err != nil {
+		return empty(), err
+	}
+
+	err = s.shareLinkStorage.Revoke(ctx, r.ProjectId, r.ShareLinkId, time.Now().Round(time.Millisecond))
+	if err != nil {
+		if !IsStorageError(err) {
+			s.log.Error("failed to revoke share link", zap.Error(err))
+		}
+
+		return empty(), s.wrapError(err)
+	}
+
+	return empty(), nil
+}
+
+// GetSharedProject does not log the request body as it holds the token.
+// Unknown, revoked and expired tokens are all reported as not found.
+func (s *service) GetSharedProject(
+	ctx context.Context,
+	r *todopb.GetSharedProjectRequest,
+) (*todopb.SharedProject, error) {
+	s.log.Debug("get shared project request")
+
+	err := r.ValidateAll()
+	if err != nil {
+		s.log.Debug("get shared project invalid request", zap.String("error", err.Error()))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	linkID, secret, ok := todopb.ParseShareToken(r.Token)
+	if !ok {
+		return nil, s.wrapError(ErrShareLinkNotFound)
+	}
+
+	link, secretHash, err := s.shareLinkStorage.ByID(ctx, linkID)
+	if err != nil {
+		if !errors.Is(err, ErrShareLinkNotFound) {
+			s.log.Error("failed to retrieve share link", zap.Error(err))
+		}
+
+		return nil, s.wrapError(err)
+	}
+
+	now := time.Now().Round(time.Millisecond)
+
+	if !todopb.ShareSecretMatches(secret, secretHash) || !link.IsActive(now) {
+		return nil, s.wrapError(ErrShareLinkNotFound)
+	}
+
+	p, err := s.storage.ByID(ctx, link.ProjectId)
+	if err != nil {
+		if !errors.Is(err, ErrProjectNotFound) {
+			s.log.Error("failed to retrieve project", zap.Error(err))
+		}
+
+		return nil, s.wrapError(err)
+	}
+
+	err = s.shareLinkStorage.RecordAccess(ctx, link.Id, now)
+	if err != nil {
+		s.log.Error("failed to record share link access", zap.Error(err))
+		return nil, s.wrapError(err)
+	}
+
+	s.log.Info(
+		"shared project accessed",
+		zap.String("share_link_id", link.Id),
+		zap.String("project_id", link.ProjectId),
+		zap.String("remote_addr", remoteAddr(ctx)),
+	)
+
+	return p.Shared(), nil
+}
+
+func remoteAddr(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return ""
+	}
+
+	return p.Addr.String()
+}
@@ -0,0 +1,59 @@
+package todo
+
+import (
+	"context"
+	"time"
+
+	"github.com/sladonia/todo-sv/pkg/todopb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type ShareLinkStorage interface {
+	// ByID returns the link and the hash of its token secret.
+	ByID(ctx context.Context, linkID string) (*todopb.ShareLink, []byte, error)
+	ProjectLinks(ctx context.Context, projectID string) ([]*todopb.ShareLink, error)
+	Insert(ctx context.Context, link *todopb.ShareLink, secretHash []byte) error
+	Revoke(ctx context.Context, projectID, linkID string, revokedAt time.Time) error
+	// RecordAccess counts the access to the shared project.
+	RecordAccess(ctx context.Context, linkID string, accessedAt time.Time) error
+	DeleteProjectLinks(ctx context.Context, projectID string) error
+}
+
+type ShareLinkBSON struct {
+	ID             string     `bson:"_id"`
+	ProjectID      string     `bson:"project_id"`
+	CreatedBy      string     `bson:"created_by"`
+	SecretHash     []byte     `bson:"secret_hash"`
+	CreatedAt      time.Time  `bson:"created_at"`
+	ExpiresAt      time.Time  `bson:"expires_at"`
+	RevokedAt      *time.Time `bson:"revoked_at"`
+	LastAccessedAt *time.Time `bson:"last_accessed_at"`
+	AccessCount    uint64     `bson:"access_count"`
+}
+
+func NewShareLinkBSON(l *todopb.ShareLink, secretHash []byte) ShareLinkBSON {
+	return ShareLinkBSON{
+		ID:             l.Id,
+		ProjectID:      l.ProjectId,
+		CreatedBy:      l.CreatedBy,
+		SecretHash:     secretHash,
+		CreatedAt:      l.CreatedAt.AsTime(),
+		ExpiresAt:      l.ExpiresAt.AsTime(),
+		RevokedAt:      timePtr(l.RevokedAt),
+		LastAccessedAt: timePtr(l.LastAccessedAt),
+		AccessCount:    l.AccessCount,
+	}
+}
+
+func (l *ShareLinkBSON) ShareLink() *todopb.ShareLink {
+	return &todopb.ShareLink{
+		Id:             l.ID,
+		ProjectId:      l.ProjectID,
+		CreatedBy:      l.CreatedBy,
+		CreatedAt:      timestamppb.New(l.CreatedAt),
+		ExpiresAt:      timestamppb.New(l.ExpiresAt),
+		RevokedAt:      timestampPtr(l.RevokedAt),
+		LastAccessedAt: timestampPtr(l.LastAccessedAt),
+		AccessCount:    l.AccessCount,
+	}
+}
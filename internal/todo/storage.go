@@ -11,6 +11,9 @@ import (
 type Storage interface {
 	ByID(ctx context.Context, projectID string) (*todopb.Project, error)
 	AllUserProjects(ctx context.Context, userID string) ([]*todopb.Project, error)
+	Children(ctx context.Context, parentID string) ([]*todopb.Project, error)
+	CountOwnerProjects(ctx context.Context, ownerID string) (int64, error)
+	TeamProjects(ctx context.Context, teamID string) ([]*todopb.Project, error)
 	Insert(ctx context.Context, project *todopb.Project) error
 	Replace(ctx context.Context, prev, curr *todopb.Project) error
 	Delete(ctx context.Context, projectID string) error
@@ -25,18 +28,88 @@ type ProjectBSON struct {
 	CreatedAt    time.Time           `bson:"created_at"`
 	UpdatedAt    time.Time           `bson:"updated_at"`
 	Version      string              `bson:"version"`
+	Sections     []SectionBSON       `bson:"sections"`
+	CustomFields []CustomFieldBSON   `bson:"custom_fields"`
+	Statuses     []TaskStatusBSON    `bson:"statuses"`
+	Description  string              `bson:"description"`
+	Color        string              `bson:"color"`
+	Icon         string              `bson:"icon"`
+	Archived     bool                `bson:"archived"`
+	ParentID     string              `bson:"parent_id"`
+
+	PendingOwnerID        string                 `bson:"pending_owner_id"`
+	InheritedParticipants []string               `bson:"inherited_participants"`
+	Roles                 map[string]todopb.Role `bson:"roles"`
+	InheritedRoles        map[string]todopb.Role `bson:"inherited_roles"`
+	TeamIDs               []string               `bson:"team_ids"`
+	TeamMembers           []string               `bson:"team_members"`
 }
 
 type TaskBSON struct {
+	ID               string                          `bson:"id"`
+	Title            string                          `bson:"title"`
+	Description      string                          `bson:"description"`
+	Tags             []string                        `bson:"tags"`
+	IsImportant      bool                            `bson:"is_important"`
+	IsFinished       bool                            `bson:"is_finished"`
+	CreatedAt        time.Time                       `bson:"created_at"`
+	UpdatedAt        time.Time                       `bson:"updated_at"`
+	Version          string                          `bson:"version"`
+	Attachments      []AttachmentBSON                `bson:"attachments"`
+	BlockedBy        []TaskRefBSON                   `bson:"blocked_by"`
+	SectionID        string                          `bson:"section_id"`
+	DueAt            *time.Time                      `bson:"due_at,omitempty"`
+	Estimate         *EstimateBSON                   `bson:"estimate,omitempty"`
+	FinishedAt       *time.Time                      `bson:"finished_at,omitempty"`
+	FinishedBy       string                          `bson:"finished_by,omitempty"`
+	CustomFields     map[string]CustomFieldValueBSON `bson:"custom_fields,omitempty"`
+	Status           string                          `bson:"status"`
+	MentionedUserIDs []string                        `bson:"mentioned_user_ids,omitempty"`
+}
+
+type TaskStatusBSON struct {
+	ID          string   `bson:"id"`
+	Name        string   `bson:"name"`
+	IsDone      bool     `bson:"is_done"`
+	Transitions []string `bson:"transitions"`
+}
+
+type EstimateBSON struct {
+	Points  uint32 `bson:"points"`
+	Minutes uint32 `bson:"minutes"`
+}
+
+type CustomFieldBSON struct {
+	Key     string   `bson:"key"`
+	Name    string   `bson:"name"`
+	Type    int32    `bson:"type"`
+	Options []string `bson:"options"`
+}
+
+type CustomFieldValueBSON struct {
+	Text   string     `bson:"text,omitempty"`
+	Number float64    `bson:"number,omitempty"`
+	Date   *time.Time `bson:"date,omitempty"`
+}
+
+type SectionBSON struct {
+	ID   string `bson:"id"`
+	Name string `bson:"name"`
+}
+
+type TaskRefBSON struct {
+	ProjectID string `bson:"project_id"`
+	TaskID    string `bson:"task_id"`
+}
+
+type AttachmentBSON struct {
 	ID          string    `bson:"id"`
-	Title       string    `bson:"title"`
-	Description string    `bson:"description"`
-	Tags        []string  `bson:"tags"`
-	IsImportant bool      `bson:"is_important"`
-	IsFinished  bool      `bson:"is_finished"`
+	Name        string    `bson:"name"`
+	ContentType string    `bson:"content_type"`
+	Size        int64     `bson:"size"`
+	Checksum    string    `bson:"checksum"`
+	UploadedBy  string    `bson:"uploaded_by"`
 	CreatedAt   time.Time `bson:"created_at"`
-	UpdatedAt   time.Time `bson:"updated_at"`
-	Version     string    `bson:"version"`
 }
 
 func NewProjectBSON(p *todopb.Project) ProjectBSON {
@@ -47,6 +120,34 @@ func NewProjectBSON(p *todopb.Project) ProjectBSON {
 		tasks[id] = taskBSON
 	}
 
+	var sections []SectionBSON
+
+	for _, s := range p.Sections {
+		sections = append(sections, SectionBSON{ID: s.Id, Name: s.Name})
+	}
+
+	var statuses []TaskStatusBSON
+
+	for _, st := range p.Statuses {
+		statuses = append(statuses, TaskStatusBSON{
+			ID:          st.Id,
+			Name:        st.Name,
+			IsDone:      st.IsDone,
+			Transitions: st.Transitions,
+		})
+	}
+
+	var customFields []CustomFieldBSON
+
+	for _, f := range p.CustomFields {
+		customFields = append(customFields, CustomFieldBSON{
+			Key:     f.Key,
+			Name:    f.Name,
+			Type:    int32(f.Type),
+			Options: f.Options,
+		})
+	}
+
 	return ProjectBSON{
 		ID:           p.Id,
 		Name:         p.Name,
@@ -56,20 +157,98 @@ func NewProjectBSON(p *todopb.Project) ProjectBSON {
 		CreatedAt:    p.CreatedAt.AsTime(),
 		UpdatedAt:    p.UpdatedAt.AsTime(),
 		Version:      p.Version,
+		Sections:     sections,
+		CustomFields: customFields,
+		Statuses:     statuses,
+		Description:  p.Description,
+		Color:        p.Color,
+		Icon:         p.Icon,
+		Archived:     p.Archived,
+		ParentID:     p.ParentId,
+
+		PendingOwnerID:        p.PendingOwnerId,
+		InheritedParticipants: p.InheritedParticipants,
+		Roles:                 p.Roles,
+		InheritedRoles:        p.InheritedRoles,
+		TeamIDs:               p.TeamIds,
+		TeamMembers:           p.TeamMembers,
 	}
 }
 
 func NewTaskBSON(t *todopb.Task) TaskBSON {
+	var attachments []AttachmentBSON
+
+	for _, a := range t.Attachments {
+		attachments = append(attachments, NewAttachmentBSON(a))
+	}
+
+	var blockedBy []TaskRefBSON
+
+	for _, b := range t.BlockedBy {
+		blockedBy = append(blockedBy, TaskRefBSON{ProjectID: b.ProjectId, TaskID: b.TaskId})
+	}
+
 	return TaskBSON{
-		ID:          t.Id,
-		Title:       t.Title,
-		Description: t.Description,
-		Tags:        t.Tags,
-		IsImportant: t.IsImportant,
-		IsFinished:  t.IsFinished,
-		CreatedAt:   t.CreatedAt.AsTime(),
-		UpdatedAt:   t.UpdatedAt.AsTime(),
-		Version:     t.Version,
+		ID:               t.Id,
+		Title:            t.Title,
+		Description:      t.Description,
+		Tags:             t.Tags,
+		IsImportant:      t.IsImportant,
+		IsFinished:       t.IsFinished,
+		CreatedAt:        t.CreatedAt.AsTime(),
+		UpdatedAt:        t.UpdatedAt.AsTime(),
+		Version:          t.Version,
+		Attachments:      attachments,
+		BlockedBy:        blockedBy,
+		SectionID:        t.SectionId,
+		DueAt:            timePtr(t.DueAt),
+		Estimate:         newEstimateBSON(t.Estimate),
+		FinishedAt:       timePtr(t.FinishedAt),
+		FinishedBy:       t.FinishedBy,
+		CustomFields:     newCustomFieldValuesBSON(t.CustomFields),
+		Status:           t.Status,
+		MentionedUserIDs: t.MentionedUserIds,
+	}
+}
+
+func newCustomFieldValuesBSON(values map[string]*todopb.CustomFieldValue) map[string]CustomFieldValueBSON {
+	if len(values) == 0 {
+		return nil
+	}
+
+	valuesBSON := make(map[string]CustomFieldValueBSON, len(values))
+
+	for key, v := range values {
+		valuesBSON[key] = CustomFieldValueBSON{
+			Text:   v.Text,
+			Number: v.Number,
+			Date:   timePtr(v.Date),
+		}
+	}
+
+	return valuesBSON
+}
+
+func newEstimateBSON(e *todopb.Estimate) *EstimateBSON {
+	if e == nil {
+		return nil
+	}
+
+	return &EstimateBSON{
+		Points:  e.Points,
+		Minutes: e.Minutes,
+	}
+}
+
+func NewAttachmentBSON(a *todopb.Attachment) AttachmentBSON {
+	return AttachmentBSON{
+		ID:          a.Id,
+		Name:        a.Name,
+		ContentType: a.ContentType,
+		Size:        a.Size,
+		Checksum:    a.Checksum,
+		UploadedBy:  a.UploadedBy,
+		CreatedAt:   a.CreatedAt.AsTime(),
 	}
 }
 
@@ -81,6 +260,34 @@ func (p *ProjectBSON) Project() *todopb.Project {
 		tasks[id] = task
 	}
 
+	var sections []*todopb.Section
+
+	for _, s := range p.Sections {
+		sections = append(sections, &todopb.Section{Id: s.ID, Name: s.Name})
+	}
+
+	var statuses []*todopb.TaskStatus
+
+	for _, st := range p.Statuses {
+		statuses = append(statuses, &todopb.TaskStatus{
+			Id:          st.ID,
+			Name:        st.Name,
+			IsDone:      st.IsDone,
+			Transitions: st.Transitions,
+		})
+	}
+
+	var customFields []*todopb.CustomFieldDefinition
+
+	for _, f := range p.CustomFields {
+		customFields = append(customFields, &todopb.CustomFieldDefinition{
+			Key:     f.Key,
+			Name:    f.Name,
+			Type:    todopb.CustomFieldType(f.Type),
+			Options: f.Options,
+		})
+	}
+
 	return &todopb.Project{
 		Id:           p.ID,
 		Name:         p.Name,
@@ -90,19 +297,115 @@ func (p *ProjectBSON) Project() *todopb.Project {
 		CreatedAt:    timestamppb.New(p.CreatedAt),
 		UpdatedAt:    timestamppb.New(p.UpdatedAt),
 		Version:      p.Version,
+		Sections:     sections,
+		CustomFields: customFields,
+		Statuses:     statuses,
+		Description:  p.Description,
+		Color:        p.Color,
+		Icon:         p.Icon,
+		Archived:     p.Archived,
+		ParentId:     p.ParentID,
+
+		PendingOwnerId:        p.PendingOwnerID,
+		InheritedParticipants: p.InheritedParticipants,
+		Roles:                 p.Roles,
+		InheritedRoles:        p.InheritedRoles,
+		TeamIds:               p.TeamIDs,
+		TeamMembers:           p.TeamMembers,
 	}
 }
 
 func (t *TaskBSON) Task() *todopb.Task {
+	var attachments []*todopb.Attachment
+
+	for _, attachmentBSON := range t.Attachments {
+		attachments = append(attachments, attachmentBSON.Attachment())
+	}
+
+	var blockedBy []*todopb.TaskRef
+
+	for _, b := range t.BlockedBy {
+		blockedBy = append(blockedBy, todopb.NewTaskRef(b.ProjectID, b.TaskID))
+	}
+
 	return &todopb.Task{
-		Id:          t.ID,
-		Title:       t.Title,
-		Description: t.Description,
-		Tags:        t.Tags,
-		IsImportant: t.IsImportant,
-		IsFinished:  t.IsFinished,
-		CreatedAt:   timestamppb.New(t.CreatedAt),
-		UpdatedAt:   timestamppb.New(t.UpdatedAt),
-		Version:     t.Version,
+		Id:               t.ID,
+		Title:            t.Title,
+		Description:      t.Description,
+		Tags:             t.Tags,
+		IsImportant:      t.IsImportant,
+		IsFinished:       t.IsFinished,
+		CreatedAt:        timestamppb.New(t.CreatedAt),
+		UpdatedAt:        timestamppb.New(t.UpdatedAt),
+		Version:          t.Version,
+		Attachments:      attachments,
+		BlockedBy:        blockedBy,
+		SectionId:        t.SectionID,
+		DueAt:            timestampPtr(t.DueAt),
+		Estimate:         t.Estimate.Estimate(),
+		FinishedAt:       timestampPtr(t.FinishedAt),
+		FinishedBy:       t.FinishedBy,
+		CustomFields:     t.customFieldValues(),
+		Status:           t.Status,
+		MentionedUserIds: t.MentionedUserIDs,
+	}
+}
+
+func (t *TaskBSON) customFieldValues() map[string]*todopb.CustomFieldValue {
+	if len(t.CustomFields) == 0 {
+		return nil
 	}
+
+	values := make(map[string]*todopb.CustomFieldValue, len(t.CustomFields))
+
+	for key, v := range t.CustomFields {
+		values[key] = &todopb.CustomFieldValue{
+			Text:   v.Text,
+			Number: v.Number,
+			Date:   timestampPtr(v.Date),
+		}
+	}
+
+	return values
+}
+
+func (e *EstimateBSON) Estimate() *todopb.Estimate {
+	if e == nil {
+		return nil
+	}
+
+	return &todopb.Estimate{
+		Points:  e.Points,
+		Minutes: e.Minutes,
+	}
+}
+
+func (a *AttachmentBSON) Attachment() *todopb.Attachment {
+	return &todopb.Attachment{
+		Id:          a.ID,
+		Name:        a.Name,
+		ContentType: a.ContentType,
+		Size:        a.Size,
+		Checksum:    a.Checksum,
+		UploadedBy:  a.UploadedBy,
+		CreatedAt:   timestamppb.New(a.CreatedAt),
+	}
+}
+
+func timePtr(ts *timestamppb.Timestamp) *time.Time {
+	if ts == nil {
+		return nil
+	}
+
+	t := ts.AsTime()
+
+	return &t
+}
+
+func timestampPtr(t *time.Time) *timestamppb.Timestamp {
+	if t == nil {
+		return nil
+	}
+
+	return timestamppb.New(*t)
 }
@@ -280,6 +280,30 @@ func (s *Suite) TestReplace() {
 		err := s.storage.Replace(ctx, updated, projectFixtureInserted2)
 		s.ErrorIs(err, ErrVersionMismatch)
 	})
+
+	s.Run("stale_version", func() {
+		first := projectFixtureInserted2.WithTask(todopb.NewTask(&todopb.AddTaskRequest{
+			Title:     "buy milk",
+			ProjectId: "4",
+			UserId:    "3",
+		}))
+
+		err := s.storage.Replace(ctx, projectFixtureInserted2, first)
+		s.NoError(err)
+
+		second := projectFixtureInserted2.WithTask(todopb.NewTask(&todopb.AddTaskRequest{
+			Title:     "buy bread",
+			ProjectId: "4",
+			UserId:    "3",
+		}))
+
+		err = s.storage.Replace(ctx, projectFixtureInserted2, second)
+		s.ErrorIs(err, ErrVersionMismatch)
+
+		stored, err := s.storage.ByID(ctx, "3")
+		s.NoError(err)
+		s.Equal(first.Version, stored.Version)
+	})
 }
 
 func TestSuite(t *testing.T) {
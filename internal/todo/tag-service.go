@@ -0,0 +1,202 @@
+package todo
+
+import (
+	"context"
+	"errors"
+	"sort"
+
+	"github.com/sladonia/todo-sv/internal/authz"
+	"github.com/sladonia/todo-sv/pkg/todopb"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *service) UpsertTag(ctx context.Context, r *todopb.UpsertTagRequest) (*todopb.Tag, error) {
+	s.log.Debug("upsert tag request", zap.Any("request_body", r))
+
+	err := r.ValidateAll()
+	if err != nil {
+		s.log.Debug("upsert tag invalid request", zap.String("error", err.Error()))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	tag := todopb.NewTag(r)
+
+	err = s.tagStorage.Upsert(ctx, tag)
+	if err != nil {
+		s.log.Error("failed to upsert tag", zap.Error(err))
+		return nil, s.wrapError(err)
+	}
+
+	return tag, nil
+}
+
+// ListTags returns the catalogue of the user along with the tags that are used
+// in the user projects but are not in the catalogue yet.
+func (s *service) ListTags(ctx context.Context, r *todopb.ListTagsRequest) (*todopb.ListTagsResponse, error) {
+	s.log.Debug("list tags request", zap.Any("request_body", r))
+
+	err := r.ValidateAll()
+	if err != nil {
+		s.log.Debug("list tags invalid request", zap.String("error", err.Error()))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	catalogue, err := s.tagStorage.UserTags(ctx, r.UserId)
+	if err != nil {
+		s.log.Error("failed to retrieve tags", zap.Error(err))
+		return nil, s.wrapError(err)
+	}
+
+	projects, err := s.storage.AllUserProjects(ctx, r.UserId)
+	if err != nil {
+		s.log.Error("failed to retrieve user projects", zap.Error(err))
+		return nil, s.wrapError(err)
+	}
+
+	usage := todopb.TagUsage(projects)
+	tags := make([]*todopb.Tag, 0, len(catalogue))
+
+	for _, tag := range catalogue {
+		tag.UsageCount = usage[tag.Name]
+		delete(usage, tag.Name)
+		tags = append(tags, tag)
+	}
+
+	for name, count := range usage {
+		tags = append(tags, &todopb.Tag{Name: name, OwnerId: r.UserId, UsageCount: count})
+	}
+
+	sort.Slice(tags, func(i, j int) bool {
+		return tags[i].Name < tags[j].Name
+	})
+
+	return &todopb.ListTagsResponse{Tags: tags}, nil
+}
+
+func (s *service) RenameTag(ctx context.Context, r *todopb.RenameTagRequest) (*todopb.RewriteTagsResponse, error) {
+	s.log.Debug("rename tag request", zap.Any("request_body", r))
+
+	err := r.ValidateAll()
+	if err != nil {
+		s.log.Debug("rename tag invalid request", zap.String("error", err.Error()))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return s.rewriteTags(ctx, r.UserId, []string{r.Name}, r.NewName)
+}
+
+func (s *service) MergeTags(ctx context.Context, r *todopb.MergeTagsRequest) (*todopb.RewriteTagsResponse, error) {
+	s.log.Debug("merge tags request", zap.Any("request_body", r))
+
+	err := r.ValidateAll()
+	if err != nil {
+		s.log.Debug("merge tags invalid request", zap.String("error", err.Error()))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return s.rewriteTags(ctx, r.UserId, r.Tags, r.Target)
+}
+
+// rewriteTags replaces the tags with target in all the projects the user can
+// edit and moves the catalogue entry over to target unless it already has one.
+// Projects are replaced one by one, so a version mismatch aborts the rewrite
+// leaving the projects processed so far updated. Repeating the request
+// completes it.
+func (s *service) rewriteTags(
+	ctx context.Context,
+	userID string,
+	tags []string,
+	target string,
+) (*todopb.RewriteTagsResponse, error) {
+	projects, err := s.storage.AllUserProjects(ctx, userID)
+	if err != nil {
+		s.log.Error("failed to retrieve user projects", zap.Error(err))
+		return nil, s.wrapError(err)
+	}
+
+	res := &todopb.RewriteTagsResponse{}
+
+	for _, p := range projects {
+		if p.Archived {
+			continue
+		}
+
+		err = s.authorizer.Authorize(ctx, userID, authz.ActionUpdateTask, authz.Resource{Project: p})
+		if errors.Is(err, authz.ErrDenied) {
+			continue
+		}
+
+		if err != nil {
+			s.log.Error("failed to authorize", zap.String("action", string(authz.ActionUpdateTask)), zap.Error(err))
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
+		updatedProject, changed := p.ReplaceTags(tags, target)
+		if changed == 0 {
+			continue
+		}
+
+		err = s.replaceProject(ctx, p, updatedProject)
+		if err != nil {
+			return nil, err
+		}
+
+		res.UpdatedProjects++
+		res.UpdatedTasks += uint32(changed)
+	}
+
+	err = s.rewriteCatalogue(ctx, userID, tags, target)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (s *service) rewriteCatalogue(ctx context.Context, userID string, tags []string, target string) error {
+	_, err := s.tagStorage.ByName(ctx, userID, target)
+	targetExists := err == nil
+
+	if err != nil && !errors.Is(err, ErrTagNotFound) {
+		s.log.Error("failed to retrieve tag", zap.Error(err))
+		return s.wrapError(err)
+	}
+
+	for _, name := range tags {
+		if name == target {
+			continue
+		}
+
+		tag, err := s.tagStorage.ByName(ctx, userID, name)
+		if err != nil {
+			if errors.Is(err, ErrTagNotFound) {
+				continue
+			}
+
+			s.log.Error("failed to retrieve tag", zap.Error(err))
+			return s.wrapError(err)
+		}
+
+		if !targetExists {
+			tag.Name = target
+
+			err = s.tagStorage.Upsert(ctx, tag)
+			if err != nil {
+				s.log.Error("failed to upsert tag", zap.Error(err))
+				return s.wrapError(err)
+			}
+
+			targetExists = true
+		}
+
+		err = s.tagStorage.Delete(ctx, userID, name)
+		if err != nil {
+			s.log.Error("failed to delete tag", zap.Error(err))
+			return s.wrapError(err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,39 @@
+package todo
+
+import (
+	"context"
+
+	"github.com/sladonia/todo-sv/pkg/todopb"
+)
+
+type TagStorage interface {
+	UserTags(ctx context.Context, ownerID string) ([]*todopb.Tag, error)
+	ByName(ctx context.Context, ownerID, name string) (*todopb.Tag, error)
+	Upsert(ctx context.Context, tag *todopb.Tag) error
+	Delete(ctx context.Context, ownerID, name string) error
+}
+
+type TagBSON struct {
+	Name        string `bson:"name"`
+	OwnerID     string `bson:"owner_id"`
+	Color       string `bson:"color"`
+	Description string `bson:"description"`
+}
+
+func NewTagBSON(t *todopb.Tag) TagBSON {
+	return TagBSON{
+		Name:        t.Name,
+		OwnerID:     t.OwnerId,
+		Color:       t.Color,
+		Description: t.Description,
+	}
+}
+
+func (t *TagBSON) Tag() *todopb.Tag {
+	return &todopb.Tag{
+		Name:        t.Name,
+		OwnerId:     t.OwnerID,
+		Color:       t.Color,
+		Description: t.Description,
+	}
+}
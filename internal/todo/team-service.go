@@ -0,0 +1,291 @@
+package todo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/sladonia/todo-sv/pkg/set"
+	"github.com/sladonia/todo-sv/pkg/todopb"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func (s *service) CreateTeam(ctx context.Context, r *todopb.CreateTeamRequest) (*todopb.Team, error) {
+	s.log.Debug("create team request", zap.Any("request_body", r))
+
+	err := r.ValidateAll()
+	if err != nil {
+		s.log.Debug("create team invalid request", zap.String("error", err.Error()))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	team := todopb.NewTeam(r)
+
+	err = s.teamStorage.Insert(ctx, team)
+	if err != nil {
+		if !errors.Is(err, ErrAlreadyExists) {
+			s.log.Error("failed to insert team", zap.Error(err))
+		}
+
+		return nil, s.wrapError(err)
+	}
+
+	err = s.notifyTeamMembers(ctx, team, team.Members)
+	if err != nil {
+		return nil, err
+	}
+
+	return team, nil
+}
+
+func (s *service) GetTeam(ctx context.Context, r *todopb.GetTeamRequest) (*todopb.Team, error) {
+	s.log.Debug("get team request", zap.Any("request_body", r))
+
+	err := r.ValidateAll()
+	if err != nil {
+		s.log.Debug("get team invalid request", zap.String("error", err.Error()))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	team, err := s.teamByID(ctx, r.TeamId)
+	if err != nil {
+		return nil, err
+	}
+
+	if !team.IsMember(r.UserId) {
+		return nil, status.Error(
+			codes.PermissionDenied,
+			fmt.Sprintf("user %s is not a member of %s team", r.UserId, r.TeamId),
+		)
+	}
+
+	return team, nil
+}
+
+func (s *service) ListTeams(ctx context.Context, r *todopb.ListTeamsRequest) (*todopb.ListTeamsResponse, error) {
+	s.log.Debug("list teams request", zap.Any("request_body", r))
+
+	err := r.ValidateAll()
+	if err != nil {
+		s.log.Debug("list teams invalid request", zap.String("error", err.Error()))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	teams, err := s.teamStorage.UserTeams(ctx, r.UserId)
+	if err != nil {
+		s.log.Error("failed to retrieve user teams", zap.Error(err))
+		return nil, s.wrapError(err)
+	}
+
+	return &todopb.ListTeamsResponse{Teams: teams}, nil
+}
+
+func (s *service) UpdateTeam(ctx context.Context, r *todopb.UpdateTeamRequest) (*todopb.Team, error) {
+	s.log.Debug("update team request", zap.Any("request_body", r))
+
+	err := r.ValidateAll()
+	if err != nil {
+		s.log.Debug("update team invalid request", zap.String("error", err.Error()))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	team, err := s.ownedTeam(ctx, r.TeamId, r.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	updatedTeam := team.Update(r)
+	if updatedTeam.Name == "" {
+		return nil, status.Error(codes.InvalidArgument, "team name can not be empty")
+	}
+
+	err = s.teamStorage.Replace(ctx, team, updatedTeam)
+	if err != nil {
+		if !IsStorageError(err) {
+			s.log.Error("failed to replace team", zap.Error(err))
+		}
+
+		return nil, s.wrapError(err)
+	}
+
+	changed := team.ChangedMembers(updatedTeam)
+	if len(changed) == 0 {
+		return updatedTeam, nil
+	}
+
+	err = s.syncTeamProjects(ctx, team.Id, false)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.notifyTeamMembers(ctx, updatedTeam, changed)
+	if err != nil {
+		return nil, err
+	}
+
+	return updatedTeam, nil
+}
+
+func (s *service) DeleteTeam(ctx context.Context, r *todopb.DeleteTeamRequest) (*emptypb.Empty, error) {
+	s.log.Debug("delete team request", zap.Any("request_body", r))
+
+	err := r.ValidateAll()
+	if err != nil {
+		s.log.Debug("delete team invalid request", zap.String("error", err.Error()))
+		return empty(), status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	team, err := s.ownedTeam(ctx, r.TeamId, r.UserId)
+	if err != nil {
+		return empty(), err
+	}
+
+	err = s.teamStorage.Delete(ctx, r.TeamId)
+	if err != nil {
+		s.log.Error("failed to delete team", zap.Error(err))
+		return empty(), s.wrapError(err)
+	}
+
+	err = s.syncTeamProjects(ctx, r.TeamId, true)
+	if err != nil {
+		return empty(), err
+	}
+
+	err = s.notifyTeamMembers(ctx, team, team.Members)
+	if err != nil {
+		return empty(), err
+	}
+
+	return empty(), nil
+}
+
+func (s *service) teamByID(ctx context.Context, teamID string) (*todopb.Team, error) {
+	team, err := s.teamStorage.ByID(ctx, teamID)
+	if err != nil {
+		if !errors.Is(err, ErrTeamNotFound) {
+			s.log.Error("failed to retrieve team", zap.Error(err))
+		}
+
+		return nil, s.wrapError(err)
+	}
+
+	return team, nil
+}
+
+// ownedTeam retrieves the team making sure the user is its owner.
+func (s *service) ownedTeam(ctx context.Context, teamID, userID string) (*todopb.Team, error) {
+	team, err := s.teamByID(ctx, teamID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !team.IsOwner(userID) {
+		return nil, status.Error(
+			codes.PermissionDenied,
+			fmt.Sprintf("user %s is not the owner of %s team", userID, teamID),
+		)
+	}
+
+	return team, nil
+}
+
+// checkSharedTeams makes sure the teams a project is being shared with exist
+// and the user is their member.
+func (s *service) checkSharedTeams(ctx context.Context, userID string, teamIDs []string) error {
+	teams, err := s.teamStorage.ByIDs(ctx, teamIDs)
+	if err != nil {
+		s.log.Error("failed to retrieve teams", zap.Error(err))
+		return s.wrapError(err)
+	}
+
+	found := set.NewSet()
+
+	for _, team := range teams {
+		if !team.IsMember(userID) {
+			return status.Error(
+				codes.PermissionDenied,
+				fmt.Sprintf("user %s is not a member of %s team", userID, team.Id),
+			)
+		}
+
+		found.Add(team.Id)
+	}
+
+	for _, id := range teamIDs {
+		if !found.Contains(id) {
+			return status.Error(codes.InvalidArgument, fmt.Sprintf("team_id=%s not found", id))
+		}
+	}
+
+	return nil
+}
+
+// teamsMembers returns the members of the teams. Deleted teams are skipped.
+func (s *service) teamsMembers(ctx context.Context, teamIDs []string) ([]string, error) {
+	teams, err := s.teamStorage.ByIDs(ctx, teamIDs)
+	if err != nil {
+		s.log.Error("failed to retrieve teams", zap.Error(err))
+		return nil, s.wrapError(err)
+	}
+
+	return todopb.TeamsMembers(teams), nil
+}
+
+// syncTeamProjects updates the team members of the projects shared with the
+// team after its members changed, removing the team from the projects when
+// removeTeam is set.
+func (s *service) syncTeamProjects(ctx context.Context, teamID string, removeTeam bool) error {
+	projects, err := s.storage.TeamProjects(ctx, teamID)
+	if err != nil {
+		s.log.Error("failed to retrieve team projects", zap.Error(err))
+		return s.wrapError(err)
+	}
+
+	for _, p := range projects {
+		updatedProject := p
+		if removeTeam {
+			updatedProject = p.WithoutTeam(teamID)
+		}
+
+		members, err := s.teamsMembers(ctx, updatedProject.TeamIds)
+		if err != nil {
+			return err
+		}
+
+		updatedProject, changed := updatedProject.WithTeamMembers(members)
+		if !changed && !removeTeam {
+			continue
+		}
+
+		err = s.replaceProject(ctx, p, updatedProject)
+		if err != nil {
+			return err
+		}
+
+		err = s.propagateParticipants(ctx, updatedProject)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// notifyTeamMembers delivers the team on the event streams of the users whose
+// membership changed.
+func (s *service) notifyTeamMembers(ctx context.Context, team *todopb.Team, userIDs []string) error {
+	ev := todopb.NewTeamMembershipChangedEvent(team)
+
+	for _, userID := range userIDs {
+		err := s.pubSub.Publish(ctx, todopb.NewUserEventsSubject(ev.Type.String(), userID), ev)
+		if err != nil {
+			s.log.Error("publish team membership changed event", zap.Error(err))
+			return s.wrapError(err)
+		}
+	}
+
+	return nil
+}
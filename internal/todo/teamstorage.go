@@ -0,0 +1,54 @@
+package todo
+
+import (
+	"context"
+	"time"
+
+	"github.com/sladonia/todo-sv/pkg/todopb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type TeamStorage interface {
+	ByID(ctx context.Context, teamID string) (*todopb.Team, error)
+	// ByIDs returns the existing teams out of the given ones.
+	ByIDs(ctx context.Context, teamIDs []string) ([]*todopb.Team, error)
+	// UserTeams returns the teams the user owns or is a member of.
+	UserTeams(ctx context.Context, userID string) ([]*todopb.Team, error)
+	Insert(ctx context.Context, team *todopb.Team) error
+	Replace(ctx context.Context, prev, curr *todopb.Team) error
+	Delete(ctx context.Context, teamID string) error
+}
+
+type TeamBSON struct {
+	ID        string    `bson:"_id"`
+	Name      string    `bson:"name"`
+	OwnerID   string    `bson:"owner_id"`
+	Members   []string  `bson:"members"`
+	CreatedAt time.Time `bson:"created_at"`
+	UpdatedAt time.Time `bson:"updated_at"`
+	Version   string    `bson:"version"`
+}
+
+func NewTeamBSON(t *todopb.Team) TeamBSON {
+	return TeamBSON{
+		ID:        t.Id,
+		Name:      t.Name,
+		OwnerID:   t.OwnerId,
+		Members:   t.Members,
+		CreatedAt: t.CreatedAt.AsTime(),
+		UpdatedAt: t.UpdatedAt.AsTime(),
+		Version:   t.Version,
+	}
+}
+
+func (t TeamBSON) Team() *todopb.Team {
+	return &todopb.Team{
+		Id:        t.ID,
+		Name:      t.Name,
+		OwnerId:   t.OwnerID,
+		Members:   t.Members,
+		CreatedAt: timestamppb.New(t.CreatedAt),
+		UpdatedAt: timestamppb.New(t.UpdatedAt),
+		Version:   t.Version,
+	}
+}
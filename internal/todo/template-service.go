@@ -0,0 +1,197 @@
+package todo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/sladonia/todo-sv/internal/authz"
+	"github.com/sladonia/todo-sv/pkg/todopb"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func (s *service) CreateTemplate(ctx context.Context, r *todopb.CreateTemplateRequest) (*todopb.Template, error) {
+	s.log.Debug("create template request", zap.Any("request_body", r))
+
+	err := r.ValidateAll()
+	if err != nil {
+		s.log.Debug("create template invalid request", zap.String("error", err.Error()))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	template := todopb.NewTemplate(r)
+
+	err = s.insertTemplate(ctx, template)
+	if err != nil {
+		return nil, err
+	}
+
+	return template, nil
+}
+
+func (s *service) SaveProjectAsTemplate(
+	ctx context.Context,
+	r *todopb.SaveProjectAsTemplateRequest,
+) (*todopb.Template, error) {
+	s.log.Debug("save project as template request", zap.Any("request_body", r))
+
+	err := r.ValidateAll()
+	if err != nil {
+		s.log.Debug("save project as template invalid request", zap.String("error", err.Error()))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	p, err := s.authorizedProject(ctx, r.ProjectId, r.UserId, authz.ActionViewProject)
+	if err != nil {
+		return nil, err
+	}
+
+	template := todopb.NewProjectTemplate(p, r.UserId, r.Name)
+
+	err = s.insertTemplate(ctx, template)
+	if err != nil {
+		return nil, err
+	}
+
+	return template, nil
+}
+
+func (s *service) ListTemplates(
+	ctx context.Context,
+	r *todopb.ListTemplatesRequest,
+) (*todopb.ListTemplatesResponse, error) {
+	s.log.Debug("list templates request", zap.Any("request_body", r))
+
+	err := r.ValidateAll()
+	if err != nil {
+		s.log.Debug("list templates invalid request", zap.String("error", err.Error()))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	templates, err := s.templateStorage.OwnerTemplates(ctx, r.UserId)
+	if err != nil {
+		s.log.Error("failed to retrieve templates", zap.Error(err))
+		return nil, s.wrapError(err)
+	}
+
+	return &todopb.ListTemplatesResponse{Templates: templates}, nil
+}
+
+func (s *service) DeleteTemplate(ctx context.Context, r *todopb.DeleteTemplateRequest) (*emptypb.Empty, error) {
+	s.log.Debug("delete template request", zap.Any("request_body", r))
+
+	err := r.ValidateAll()
+	if err != nil {
+		s.log.Debug("delete template invalid request", zap.String("error", err.Error()))
+		return empty(), status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	_, err = s.ownedTemplate(ctx, r.TemplateId, r.UserId)
+	if err != nil {
+		return empty(), err
+	}
+
+	err = s.templateStorage.Delete(ctx, r.TemplateId)
+	if err != nil {
+		s.log.Error("failed to delete template", zap.Error(err))
+		return empty(), s.wrapError(err)
+	}
+
+	return empty(), nil
+}
+
+func (s *service) CreateProjectFromTemplate(
+	ctx context.Context,
+	r *todopb.CreateProjectFromTemplateRequest,
+) (*todopb.Project, error) {
+	s.log.Debug("create project from template request", zap.Any("request_body", r))
+
+	err := r.ValidateAll()
+	if err != nil {
+		s.log.Debug("create project from template invalid request", zap.String("error", err.Error()))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	template, err := s.ownedTemplate(ctx, r.TemplateId, r.OwnerId)
+	if err != nil {
+		return nil, err
+	}
+
+	project := template.NewProject(r)
+
+	err = s.checkProjectQuota(ctx, r.OwnerId)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.checkTaskQuota(project, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.checkParticipantQuota(project, len(project.NonMembers(r.Participants)))
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.storage.Insert(ctx, project)
+	if err != nil {
+		if !errors.Is(err, ErrAlreadyExists) {
+			s.log.Error("failed to insert project to db", zap.String("error", err.Error()))
+		}
+
+		return nil, s.wrapError(err)
+	}
+
+	ev := todopb.NewProjectCreatedEvent(project)
+
+	err = s.pubSub.Publish(ctx, todopb.NewProjectSubject(ev.Type.String(), project.Id), ev)
+	if err != nil {
+		s.log.Error("publish project created event", zap.Error(err))
+		return nil, s.wrapError(err)
+	}
+
+	err = s.inviteParticipants(ctx, project, r.OwnerId, r.Participants)
+	if err != nil {
+		return nil, err
+	}
+
+	return project, nil
+}
+
+func (s *service) insertTemplate(ctx context.Context, template *todopb.Template) error {
+	err := s.templateStorage.Insert(ctx, template)
+	if err != nil {
+		if !errors.Is(err, ErrAlreadyExists) {
+			s.log.Error("failed to insert template to db", zap.Error(err))
+		}
+
+		return s.wrapError(err)
+	}
+
+	return nil
+}
+
+// ownedTemplate retrieves the template making sure it belongs to the user.
+func (s *service) ownedTemplate(ctx context.Context, templateID, userID string) (*todopb.Template, error) {
+	template, err := s.templateStorage.ByID(ctx, templateID)
+	if err != nil {
+		if !errors.Is(err, ErrTemplateNotFound) {
+			s.log.Error("failed to retrieve template", zap.Error(err))
+		}
+
+		return nil, s.wrapError(err)
+	}
+
+	if !template.IsOwner(userID) {
+		return nil, status.Error(
+			codes.PermissionDenied,
+			fmt.Sprintf("user %s has no access to %s template", userID, templateID),
+		)
+	}
+
+	return template, nil
+}
@@ -0,0 +1,94 @@
+package todo
+
+import (
+	"context"
+	"time"
+
+	"github.com/sladonia/todo-sv/pkg/todopb"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type TemplateStorage interface {
+	ByID(ctx context.Context, templateID string) (*todopb.Template, error)
+	OwnerTemplates(ctx context.Context, ownerID string) ([]*todopb.Template, error)
+	Insert(ctx context.Context, template *todopb.Template) error
+	Delete(ctx context.Context, templateID string) error
+}
+
+type TemplateBSON struct {
+	ID        string             `bson:"_id"`
+	Name      string             `bson:"name"`
+	OwnerID   string             `bson:"owner_id"`
+	Tasks     []TemplateTaskBSON `bson:"tasks"`
+	CreatedAt time.Time          `bson:"created_at"`
+	UpdatedAt time.Time          `bson:"updated_at"`
+	Version   string             `bson:"version"`
+}
+
+type TemplateTaskBSON struct {
+	Title       string         `bson:"title"`
+	Description string         `bson:"description"`
+	Tags        []string       `bson:"tags"`
+	IsImportant bool           `bson:"is_important"`
+	DueOffset   *time.Duration `bson:"due_offset,omitempty"`
+}
+
+func NewTemplateBSON(t *todopb.Template) TemplateBSON {
+	var tasks []TemplateTaskBSON
+
+	for _, task := range t.Tasks {
+		taskBSON := TemplateTaskBSON{
+			Title:       task.Title,
+			Description: task.Description,
+			Tags:        task.Tags,
+			IsImportant: task.IsImportant,
+		}
+
+		if task.DueOffset != nil {
+			dueOffset := task.DueOffset.AsDuration()
+			taskBSON.DueOffset = &dueOffset
+		}
+
+		tasks = append(tasks, taskBSON)
+	}
+
+	return TemplateBSON{
+		ID:        t.Id,
+		Name:      t.Name,
+		OwnerID:   t.OwnerId,
+		Tasks:     tasks,
+		CreatedAt: t.CreatedAt.AsTime(),
+		UpdatedAt: t.UpdatedAt.AsTime(),
+		Version:   t.Version,
+	}
+}
+
+func (t *TemplateBSON) Template() *todopb.Template {
+	var tasks []*todopb.TemplateTask
+
+	for _, taskBSON := range t.Tasks {
+		task := &todopb.TemplateTask{
+			Title:       taskBSON.Title,
+			Description: taskBSON.Description,
+			Tags:        taskBSON.Tags,
+			IsImportant: taskBSON.IsImportant,
+		}
+
+		if taskBSON.DueOffset != nil {
+			task.DueOffset = durationpb.New(*taskBSON.DueOffset)
+		}
+
+		tasks = append(tasks, task)
+	}
+
+	return &todopb.Template{
+		Id:        t.ID,
+		Name:      t.Name,
+		OwnerId:   t.OwnerID,
+		Tasks:     tasks,
+		CreatedAt: timestamppb.New(t.CreatedAt),
+		UpdatedAt: timestamppb.New(t.UpdatedAt),
+		Version:   t.Version,
+	}
+}
@@ -0,0 +1,302 @@
+package todo
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/sladonia/todo-sv/internal/authz"
+	"github.com/sladonia/todo-sv/pkg/todopb"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// StartTimer starts tracking time on the task. A user can have only one
+// running timer, it has to be stopped before another one is started.
+func (s *service) StartTimer(ctx context.Context, r *todopb.StartTimerRequest) (*todopb.TimeEntry, error) {
+	s.log.Debug("start timer request", zap.Any("request_body", r))
+
+	err := r.ValidateAll()
+	if err != nil {
+		s.log.Debug("start timer invalid request", zap.String("error", err.Error()))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	_, err = s.writableTask(ctx, r.ProjectId, r.TaskId, r.UserId, authz.ActionTrackTime)
+	if err != nil {
+		return nil, err
+	}
+
+	running, err := s.timeEntryStorage.RunningEntry(ctx, r.UserId)
+	if err == nil {
+		return nil, status.Error(
+			codes.FailedPrecondition,
+			fmt.Sprintf("user %s already has a running timer on task_id=%s", r.UserId, running.TaskId),
+		)
+	}
+
+	if !errors.Is(err, ErrTimeEntryNotFound) {
+		s.log.Error("failed to retrieve running time entry", zap.Error(err))
+		return nil, s.wrapError(err)
+	}
+
+	entry := todopb.NewRunningTimeEntry(r)
+
+	err = s.insertTimeEntry(ctx, entry)
+	if err != nil {
+		return nil, err
+	}
+
+	return entry, nil
+}
+
+func (s *service) StopTimer(ctx context.Context, r *todopb.StopTimerRequest) (*todopb.TimeEntry, error) {
+	s.log.Debug("stop timer request", zap.Any("request_body", r))
+
+	err := r.ValidateAll()
+	if err != nil {
+		s.log.Debug("stop timer invalid request", zap.String("error", err.Error()))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	running, err := s.timeEntryStorage.RunningEntry(ctx, r.UserId)
+	if err != nil {
+		if !errors.Is(err, ErrTimeEntryNotFound) {
+			s.log.Error("failed to retrieve running time entry", zap.Error(err))
+		}
+
+		return nil, s.wrapError(err)
+	}
+
+	stopped := running.Stop()
+
+	err = s.replaceTimeEntry(ctx, stopped)
+	if err != nil {
+		return nil, err
+	}
+
+	return stopped, nil
+}
+
+func (s *service) AddTimeEntry(ctx context.Context, r *todopb.AddTimeEntryRequest) (*todopb.TimeEntry, error) {
+	s.log.Debug("add time entry request", zap.Any("request_body", r))
+
+	err := r.ValidateAll()
+	if err != nil {
+		s.log.Debug("add time entry invalid request", zap.String("error", err.Error()))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	err = validateTimeRange(r.StartedAt, r.EndedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = s.writableTask(ctx, r.ProjectId, r.TaskId, r.UserId, authz.ActionTrackTime)
+	if err != nil {
+		return nil, err
+	}
+
+	entry := todopb.NewTimeEntry(r)
+
+	err = s.insertTimeEntry(ctx, entry)
+	if err != nil {
+		return nil, err
+	}
+
+	return entry, nil
+}
+
+func (s *service) UpdateTimeEntry(ctx context.Context, r *todopb.UpdateTimeEntryRequest) (*todopb.TimeEntry, error) {
+	s.log.Debug("update time entry request", zap.Any("request_body", r))
+
+	err := r.ValidateAll()
+	if err != nil {
+		s.log.Debug("update time entry invalid request", zap.String("error", err.Error()))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	err = validateTimeRange(r.StartedAt, r.EndedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	entry, err := s.ownTimeEntry(ctx, r.EntryId, r.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	updated := entry.Update(r)
+
+	err = s.replaceTimeEntry(ctx, updated)
+	if err != nil {
+		return nil, err
+	}
+
+	return updated, nil
+}
+
+func (s *service) DeleteTimeEntry(ctx context.Context, r *todopb.DeleteTimeEntryRequest) (*emptypb.Empty, error) {
+	s.log.Debug("delete time entry request", zap.Any("request_body", r))
+
+	err := r.ValidateAll()
+	if err != nil {
+		s.log.Debug("delete time entry invalid request", zap.String("error", err.Error()))
+		return empty(), status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	_, err = s.ownTimeEntry(ctx, r.EntryId, r.UserId)
+	if err != nil {
+		return empty(), err
+	}
+
+	err = s.timeEntryStorage.Delete(ctx, r.EntryId)
+	if err != nil {
+		s.log.Error("failed to delete time entry", zap.Error(err))
+		return empty(), s.wrapError(err)
+	}
+
+	return empty(), nil
+}
+
+func (s *service) TimeReport(ctx context.Context, r *todopb.TimeReportRequest) (*todopb.TimeReportResponse, error) {
+	s.log.Debug("time report request", zap.Any("request_body", r))
+
+	err := r.ValidateAll()
+	if err != nil {
+		s.log.Debug("time report invalid request", zap.String("error", err.Error()))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	err = validateTimeRange(r.From, r.To)
+	if err != nil {
+		return nil, err
+	}
+
+	userProjects, err := s.storage.AllUserProjects(ctx, r.UserId)
+	if err != nil {
+		s.log.Error("failed to retrieve user projects", zap.Error(err))
+		return nil, s.wrapError(err)
+	}
+
+	var projects []*todopb.Project
+
+	projectIDs := make([]string, 0, len(userProjects))
+
+	for _, p := range userProjects {
+		ok, err := s.allowed(ctx, r.UserId, authz.ActionTrackTime, authz.Resource{Project: p})
+		if err != nil {
+			return nil, err
+		}
+
+		if ok {
+			projects = append(projects, p)
+			projectIDs = append(projectIDs, p.Id)
+		}
+	}
+
+	entries, err := s.timeEntryStorage.ProjectsEntries(ctx, projectIDs, r.From.AsTime(), r.To.AsTime())
+	if err != nil {
+		s.log.Error("failed to retrieve time entries", zap.Error(err))
+		return nil, s.wrapError(err)
+	}
+
+	rows := todopb.NewTimeReportRows(entries, projects)
+
+	if r.Format != todopb.TimeReportFormat_TIME_REPORT_CSV {
+		return &todopb.TimeReportResponse{Rows: rows}, nil
+	}
+
+	report, err := timeReportCSV(rows)
+	if err != nil {
+		s.log.Error("failed to render time report", zap.Error(err))
+		return nil, s.wrapError(err)
+	}
+
+	return &todopb.TimeReportResponse{Csv: report}, nil
+}
+
+func (s *service) insertTimeEntry(ctx context.Context, entry *todopb.TimeEntry) error {
+	err := s.timeEntryStorage.Insert(ctx, entry)
+	if err != nil {
+		if !errors.Is(err, ErrAlreadyExists) && !errors.Is(err, ErrTimerRunning) {
+			s.log.Error("failed to insert time entry to db", zap.Error(err))
+		}
+
+		return s.wrapError(err)
+	}
+
+	return nil
+}
+
+func (s *service) replaceTimeEntry(ctx context.Context, entry *todopb.TimeEntry) error {
+	err := s.timeEntryStorage.Replace(ctx, entry)
+	if err != nil {
+		if !IsStorageError(err) {
+			s.log.Error("failed to update time entry", zap.Error(err))
+		}
+
+		return s.wrapError(err)
+	}
+
+	return nil
+}
+
+// ownTimeEntry retrieves the time entry making sure it was tracked by the user.
+func (s *service) ownTimeEntry(ctx context.Context, entryID, userID string) (*todopb.TimeEntry, error) {
+	entry, err := s.timeEntryStorage.ByID(ctx, entryID)
+	if err != nil {
+		if !errors.Is(err, ErrTimeEntryNotFound) {
+			s.log.Error("failed to retrieve time entry", zap.Error(err))
+		}
+
+		return nil, s.wrapError(err)
+	}
+
+	if !entry.BelongsTo(userID) {
+		return nil, status.Error(
+			codes.PermissionDenied,
+			fmt.Sprintf("user %s has no access to %s time entry", userID, entryID),
+		)
+	}
+
+	return entry, nil
+}
+
+func validateTimeRange(from, to *timestamppb.Timestamp) error {
+	if !from.AsTime().Before(to.AsTime()) {
+		return status.Error(codes.InvalidArgument, "the end of the time range must be after its start")
+	}
+
+	return nil
+}
+
+func timeReportCSV(rows []*todopb.TimeReportRow) ([]byte, error) {
+	var buf bytes.Buffer
+
+	w := csv.NewWriter(&buf)
+
+	err := w.Write([]string{"project_id", "tag", "user_id", "seconds"})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, row := range rows {
+		seconds := strconv.FormatInt(int64(row.Duration.AsDuration().Seconds()), 10)
+
+		err = w.Write([]string{row.ProjectId, row.Tag, row.UserId, seconds})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	w.Flush()
+
+	return buf.Bytes(), w.Error()
+}
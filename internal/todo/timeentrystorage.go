@@ -0,0 +1,56 @@
+package todo
+
+import (
+	"context"
+	"time"
+
+	"github.com/sladonia/todo-sv/pkg/todopb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type TimeEntryStorage interface {
+	ByID(ctx context.Context, entryID string) (*todopb.TimeEntry, error)
+	// RunningEntry returns the entry of the user that has no end time yet.
+	RunningEntry(ctx context.Context, userID string) (*todopb.TimeEntry, error)
+	// ProjectsEntries returns the entries of the projects started within [from, to).
+	ProjectsEntries(ctx context.Context, projectIDs []string, from, to time.Time) ([]*todopb.TimeEntry, error)
+	Insert(ctx context.Context, entry *todopb.TimeEntry) error
+	Replace(ctx context.Context, entry *todopb.TimeEntry) error
+	Delete(ctx context.Context, entryID string) error
+	DeleteTaskEntries(ctx context.Context, projectID, taskID string) error
+	DeleteProjectEntries(ctx context.Context, projectID string) error
+}
+
+type TimeEntryBSON struct {
+	ID        string     `bson:"_id"`
+	ProjectID string     `bson:"project_id"`
+	TaskID    string     `bson:"task_id"`
+	UserID    string     `bson:"user_id"`
+	StartedAt time.Time  `bson:"started_at"`
+	EndedAt   *time.Time `bson:"ended_at"`
+	Note      string     `bson:"note"`
+}
+
+func NewTimeEntryBSON(e *todopb.TimeEntry) TimeEntryBSON {
+	return TimeEntryBSON{
+		ID:        e.Id,
+		ProjectID: e.ProjectId,
+		TaskID:    e.TaskId,
+		UserID:    e.UserId,
+		StartedAt: e.StartedAt.AsTime(),
+		EndedAt:   timePtr(e.EndedAt),
+		Note:      e.Note,
+	}
+}
+
+func (e *TimeEntryBSON) TimeEntry() *todopb.TimeEntry {
+	return &todopb.TimeEntry{
+		Id:        e.ID,
+		ProjectId: e.ProjectID,
+		TaskId:    e.TaskID,
+		UserId:    e.UserID,
+		StartedAt: timestamppb.New(e.StartedAt),
+		EndedAt:   timestampPtr(e.EndedAt),
+		Note:      e.Note,
+	}
+}
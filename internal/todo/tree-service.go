@@ -0,0 +1,151 @@
+package todo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/sladonia/todo-sv/internal/authz"
+	"github.com/sladonia/todo-sv/pkg/todopb"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func (s *service) MoveProject(ctx context.Context, r *todopb.MoveProjectRequest) (*emptypb.Empty, error) {
+	s.log.Debug("move project request", zap.Any("request_body", r))
+
+	err := r.ValidateAll()
+	if err != nil {
+		s.log.Debug("move project invalid request", zap.String("error", err.Error()))
+		return empty(), status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	p, err := s.authorizedProject(ctx, r.ProjectId, r.UserId, authz.ActionMoveProject)
+	if err != nil {
+		return empty(), err
+	}
+
+	if p.ParentId == r.ParentId {
+		return empty(), nil
+	}
+
+	var parent *todopb.Project
+
+	if r.ParentId != "" {
+		parent, err = s.authorizedProject(ctx, r.ParentId, r.UserId, authz.ActionAddChildProject)
+		if err != nil {
+			return empty(), err
+		}
+
+		cycle, err := s.isDescendant(ctx, parent, p.Id)
+		if err != nil {
+			return empty(), err
+		}
+
+		if cycle {
+			return empty(), status.Error(
+				codes.FailedPrecondition,
+				fmt.Sprintf("moving project_id=%s under project_id=%s creates a cycle", r.ProjectId, r.ParentId),
+			)
+		}
+	}
+
+	updatedProject := p.WithParent(parent)
+
+	err = s.replaceProject(ctx, p, updatedProject)
+	if err != nil {
+		return empty(), err
+	}
+
+	err = s.propagateParticipants(ctx, updatedProject)
+	if err != nil {
+		return empty(), err
+	}
+
+	return empty(), nil
+}
+
+func (s *service) GetProjectTree(ctx context.Context, r *todopb.GetProjectTreeRequest) (*todopb.ProjectTree, error) {
+	s.log.Debug("get project tree request", zap.Any("request_body", r))
+
+	err := r.ValidateAll()
+	if err != nil {
+		s.log.Debug("get project tree invalid request", zap.String("error", err.Error()))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	projects, err := s.storage.AllUserProjects(ctx, r.UserId)
+	if err != nil {
+		s.log.Error("failed to retrieve projects from storage", zap.Error(err))
+		return nil, s.wrapError(err)
+	}
+
+	if !r.IncludeArchived {
+		projects = todopb.ActiveProjects(projects)
+	}
+
+	return todopb.NewProjectTree(projects), nil
+}
+
+// isDescendant reports whether the project is projectID itself or one of its
+// children at any depth.
+func (s *service) isDescendant(ctx context.Context, p *todopb.Project, projectID string) (bool, error) {
+	visited := map[string]struct{}{}
+
+	for {
+		if p.Id == projectID {
+			return true, nil
+		}
+
+		if _, ok := visited[p.Id]; ok || p.ParentId == "" {
+			return false, nil
+		}
+
+		visited[p.Id] = struct{}{}
+
+		parent, err := s.storage.ByID(ctx, p.ParentId)
+		if err != nil {
+			if errors.Is(err, ErrProjectNotFound) {
+				return false, nil
+			}
+
+			s.log.Error("failed to retrieve project", zap.Error(err))
+			return false, s.wrapError(err)
+		}
+
+		p = parent
+	}
+}
+
+// propagateParticipants updates the inherited participants of the project
+// children after the project participants or its place in the tree changed.
+func (s *service) propagateParticipants(ctx context.Context, p *todopb.Project) error {
+	children, err := s.storage.Children(ctx, p.Id)
+	if err != nil {
+		s.log.Error("failed to retrieve child projects", zap.Error(err))
+		return s.wrapError(err)
+	}
+
+	participants, roles := p.ChildParticipants(), p.ChildRoles()
+
+	for _, child := range children {
+		updatedChild, changed := child.WithInheritedMembers(participants, roles)
+		if !changed {
+			continue
+		}
+
+		err = s.replaceProject(ctx, child, updatedChild)
+		if err != nil {
+			return err
+		}
+
+		err = s.propagateParticipants(ctx, updatedChild)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
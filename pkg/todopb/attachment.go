@@ -0,0 +1,30 @@
+package todopb
+
+import (
+	"github.com/rs/xid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// NewAttachment creates an attachment out of the upload metadata. Size and
+// checksum are known only once the content is stored and are set afterwards.
+func NewAttachment(m *AttachmentMetadata) *Attachment {
+	return &Attachment{
+		Id:          xid.New().String(),
+		Name:        m.Name,
+		ContentType: m.ContentType,
+		UploadedBy:  m.UserId,
+		CreatedAt:   timestampNowMilliseconds(),
+	}
+}
+
+func (x *Attachment) clone() *Attachment {
+	return &Attachment{
+		Id:          x.Id,
+		Name:        x.Name,
+		ContentType: x.ContentType,
+		Size:        x.Size,
+		Checksum:    x.Checksum,
+		UploadedBy:  x.UploadedBy,
+		CreatedAt:   timestamppb.New(x.CreatedAt.AsTime()),
+	}
+}
@@ -0,0 +1,51 @@
+package todopb
+
+import (
+	"github.com/rs/xid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func NewComment(r *AddCommentRequest) *Comment {
+	now := timestampNowMilliseconds()
+
+	return &Comment{
+		Id:               xid.New().String(),
+		ProjectId:        r.ProjectId,
+		TaskId:           r.TaskId,
+		AuthorId:         r.UserId,
+		Text:             r.Text,
+		MentionedUserIds: ParseMentions(r.Text),
+		CreatedAt:        now,
+		UpdatedAt:        now,
+		Version:          xid.New().String(),
+	}
+}
+
+func (x *Comment) Update(r *UpdateCommentRequest) *Comment {
+	updated := x.clone()
+
+	updated.Text = r.Text
+	updated.MentionedUserIds = ParseMentions(r.Text)
+	updated.Version = xid.New().String()
+	updated.UpdatedAt = timestampNowMilliseconds()
+
+	return updated
+}
+
+func (x *Comment) IsAuthor(userID string) bool {
+	return x.AuthorId == userID
+}
+
+func (x *Comment) clone() *Comment {
+	return &Comment{
+		Id:               x.Id,
+		ProjectId:        x.ProjectId,
+		TaskId:           x.TaskId,
+		AuthorId:         x.AuthorId,
+		Text:             x.Text,
+		CreatedAt:        timestamppb.New(x.CreatedAt.AsTime()),
+		UpdatedAt:        timestamppb.New(x.UpdatedAt.AsTime()),
+		Version:          x.Version,
+		MentionedUserIds: cloneStrings(x.MentionedUserIds),
+	}
+}
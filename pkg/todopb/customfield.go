@@ -0,0 +1,222 @@
+package todopb
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/sladonia/todo-sv/pkg/set"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const customFieldsPathPrefix = UpdateTaskCustomFieldsField + "."
+
+func (x *Project) CustomField(key string) (*CustomFieldDefinition, bool) {
+	for _, f := range x.CustomFields {
+		if f.Key == key {
+			return f, true
+		}
+	}
+
+	return nil, false
+}
+
+// CheckCustomFieldDefinitions makes sure keys are unique and enum fields list
+// their options.
+func CheckCustomFieldDefinitions(definitions []*CustomFieldDefinition) error {
+	keys := set.NewSet()
+
+	for _, f := range definitions {
+		if keys.Contains(f.Key) {
+			return fmt.Errorf("custom field %s is defined more than once", f.Key)
+		}
+		keys.Add(f.Key)
+
+		if f.Type == CustomFieldType_CUSTOM_FIELD_ENUM && len(f.Options) == 0 {
+			return fmt.Errorf("enum custom field %s has no options", f.Key)
+		}
+	}
+
+	return nil
+}
+
+// CheckCustomFieldValues makes sure the values belong to the project custom
+// fields and match their types.
+func (x *Project) CheckCustomFieldValues(values map[string]*CustomFieldValue) error {
+	for key, value := range values {
+		f, ok := x.CustomField(key)
+		if !ok {
+			return fmt.Errorf("custom field %s is not defined", key)
+		}
+
+		if !x.isValidCustomFieldValue(f, value) {
+			return fmt.Errorf("invalid value of %s custom field %s", f.Type, key)
+		}
+	}
+
+	return nil
+}
+
+// CustomFieldUsers returns the sorted ids of the users set as values of the
+// user custom fields.
+func (x *Project) CustomFieldUsers(values map[string]*CustomFieldValue) []string {
+	ids := set.NewSet()
+
+	for key, value := range values {
+		f, ok := x.CustomField(key)
+		if ok && f.Type == CustomFieldType_CUSTOM_FIELD_USER {
+			ids.Add(value.Text)
+		}
+	}
+
+	users := ids.Values()
+	sort.Strings(users)
+
+	return users
+}
+
+func (x *Project) isValidCustomFieldValue(f *CustomFieldDefinition, value *CustomFieldValue) bool {
+	switch f.Type {
+	case CustomFieldType_CUSTOM_FIELD_ENUM:
+		for _, option := range f.Options {
+			if option == value.Text {
+				return true
+			}
+		}
+
+		return false
+	case CustomFieldType_CUSTOM_FIELD_DATE:
+		return value.Date != nil
+	case CustomFieldType_CUSTOM_FIELD_USER:
+		return value.Text != ""
+	}
+
+	return true
+}
+
+// FilterTasks returns the tasks whose custom field values are equal to all of
+// the filter values, ordered by id.
+func (x *Project) FilterTasks(filter map[string]*CustomFieldValue) []*Task {
+	var tasks []*Task
+
+	for _, task := range x.Tasks {
+		if task.matchesCustomFields(filter) {
+			tasks = append(tasks, task)
+		}
+	}
+
+	sort.Slice(tasks, func(i, j int) bool {
+		return tasks[i].Id < tasks[j].Id
+	})
+
+	return tasks
+}
+
+func (x *Task) matchesCustomFields(filter map[string]*CustomFieldValue) bool {
+	for key, want := range filter {
+		got, ok := x.CustomFields[key]
+		if !ok || !got.Equal(want) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (x *Task) updateCustomFields(paths []string, values map[string]*CustomFieldValue, replace bool) {
+	if replace {
+		x.CustomFields = cloneCustomFieldValues(values)
+		return
+	}
+
+	for _, path := range paths {
+		if !strings.HasPrefix(path, customFieldsPathPrefix) {
+			continue
+		}
+
+		key := strings.TrimPrefix(path, customFieldsPathPrefix)
+
+		value, ok := values[key]
+		if !ok {
+			delete(x.CustomFields, key)
+			continue
+		}
+
+		if x.CustomFields == nil {
+			x.CustomFields = make(map[string]*CustomFieldValue)
+		}
+		x.CustomFields[key] = value.clone()
+	}
+}
+
+// dropInvalidCustomFields removes the task values that are not valid for the
+// project custom fields anymore.
+func (x *Project) dropInvalidCustomFields() {
+	for _, task := range x.Tasks {
+		for key, value := range task.CustomFields {
+			f, ok := x.CustomField(key)
+			if !ok || !x.isValidCustomFieldValue(f, value) {
+				delete(task.CustomFields, key)
+			}
+		}
+	}
+}
+
+func (x *CustomFieldValue) Equal(other *CustomFieldValue) bool {
+	if x.Text != other.Text || x.Number != other.Number {
+		return false
+	}
+
+	if x.Date == nil || other.Date == nil {
+		return x.Date == nil && other.Date == nil
+	}
+
+	return x.Date.AsTime().Equal(other.Date.AsTime())
+}
+
+func (x *CustomFieldValue) clone() *CustomFieldValue {
+	var date *timestamppb.Timestamp
+	if x.Date != nil {
+		date = timestamppb.New(x.Date.AsTime())
+	}
+
+	return &CustomFieldValue{
+		Text:   x.Text,
+		Number: x.Number,
+		Date:   date,
+	}
+}
+
+func (x *CustomFieldDefinition) clone() *CustomFieldDefinition {
+	options := make([]string, len(x.Options))
+	copy(options, x.Options)
+
+	return &CustomFieldDefinition{
+		Key:     x.Key,
+		Name:    x.Name,
+		Type:    x.Type,
+		Options: options,
+	}
+}
+
+func cloneCustomFieldValues(values map[string]*CustomFieldValue) map[string]*CustomFieldValue {
+	if values == nil {
+		return nil
+	}
+
+	cloned := make(map[string]*CustomFieldValue, len(values))
+	for key, value := range values {
+		cloned[key] = value.clone()
+	}
+
+	return cloned
+}
+
+func cloneCustomFieldDefinitions(definitions []*CustomFieldDefinition) []*CustomFieldDefinition {
+	var cloned []*CustomFieldDefinition
+	for _, f := range definitions {
+		cloned = append(cloned, f.clone())
+	}
+
+	return cloned
+}
@@ -30,3 +30,50 @@ func NewProjectDeletedEvent(p *Project) *Event {
 		CreatedAt: timestampNowMilliseconds(),
 	}
 }
+
+func NewCommentAddedEvent(p *Project, c *Comment) *Event {
+	return &Event{
+		Id:        xid.New().String(),
+		Type:      EventType_COMMENT_ADDED,
+		Project:   p,
+		Comment:   c,
+		CreatedAt: timestampNowMilliseconds(),
+	}
+}
+
+func NewUserMentionedEvent(p *Project, m *Mention) *Event {
+	return &Event{
+		Id:        xid.New().String(),
+		Type:      EventType_USER_MENTIONED,
+		Project:   p,
+		Mention:   m,
+		CreatedAt: timestampNowMilliseconds(),
+	}
+}
+
+func NewPreferencesUpdatedEvent(prefs *UserPreferences) *Event {
+	return &Event{
+		Id:          xid.New().String(),
+		Type:        EventType_PREFERENCES_UPDATED,
+		Preferences: prefs,
+		CreatedAt:   timestampNowMilliseconds(),
+	}
+}
+
+func NewInvitationReceivedEvent(inv *Invitation) *Event {
+	return &Event{
+		Id:         xid.New().String(),
+		Type:       EventType_INVITATION_RECEIVED,
+		Invitation: inv,
+		CreatedAt:  timestampNowMilliseconds(),
+	}
+}
+
+func NewTeamMembershipChangedEvent(team *Team) *Event {
+	return &Event{
+		Id:        xid.New().String(),
+		Type:      EventType_TEAM_MEMBERSHIP_CHANGED,
+		Team:      team,
+		CreatedAt: timestampNowMilliseconds(),
+	}
+}
@@ -0,0 +1,62 @@
+package todopb
+
+import (
+	"time"
+
+	"github.com/rs/xid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func NewInvitation(p *Project, inviterID, inviteeID string, role Role, ttl time.Duration) *Invitation {
+	now := timestampNowMilliseconds()
+
+	return &Invitation{
+		Id:          xid.New().String(),
+		ProjectId:   p.Id,
+		ProjectName: p.Name,
+		InviterId:   inviterID,
+		InviteeId:   inviteeID,
+		Role:        role,
+		Status:      InvitationStatus_INVITATION_PENDING,
+		CreatedAt:   now,
+		ExpiresAt:   timestamppb.New(now.AsTime().Add(ttl)),
+	}
+}
+
+func (x *Invitation) IsPending() bool {
+	return x.Status == InvitationStatus_INVITATION_PENDING
+}
+
+func (x *Invitation) IsExpired(now time.Time) bool {
+	return !now.Before(x.ExpiresAt.AsTime())
+}
+
+// Respond returns the invitation answered with the status.
+func (x *Invitation) Respond(status InvitationStatus) *Invitation {
+	updated := x.clone()
+
+	updated.Status = status
+	updated.RespondedAt = timestampNowMilliseconds()
+
+	return updated
+}
+
+func (x *Invitation) clone() *Invitation {
+	var respondedAt *timestamppb.Timestamp
+	if x.RespondedAt != nil {
+		respondedAt = timestamppb.New(x.RespondedAt.AsTime())
+	}
+
+	return &Invitation{
+		Id:          x.Id,
+		ProjectId:   x.ProjectId,
+		ProjectName: x.ProjectName,
+		InviterId:   x.InviterId,
+		InviteeId:   x.InviteeId,
+		Role:        x.Role,
+		Status:      x.Status,
+		CreatedAt:   timestamppb.New(x.CreatedAt.AsTime()),
+		ExpiresAt:   timestamppb.New(x.ExpiresAt.AsTime()),
+		RespondedAt: respondedAt,
+	}
+}
@@ -0,0 +1,64 @@
+package todopb
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/sladonia/todo-sv/pkg/set"
+)
+
+// mentionRegexp matches @user_id preceded by the start of the text or a
+// non-word character, so that e-mail addresses are not taken for mentions.
+var mentionRegexp = regexp.MustCompile(`(?:^|[^\w])@([\w-]+(?:\.[\w-]+)*)`)
+
+// redactedMention replaces the mentions in the texts shown to non-members.
+const redactedMention = "@[hidden]"
+
+// ParseMentions returns the sorted ids of the users mentioned in the texts.
+func ParseMentions(texts ...string) []string {
+	ids := set.NewSet()
+
+	for _, text := range texts {
+		for _, match := range mentionRegexp.FindAllStringSubmatch(text, -1) {
+			ids.Add(match[1])
+		}
+	}
+
+	mentioned := ids.Values()
+	sort.Strings(mentioned)
+
+	return mentioned
+}
+
+// RedactMentions hides the ids of the users mentioned in the text.
+func RedactMentions(text string) string {
+	return mentionRegexp.ReplaceAllStringFunc(text, func(match string) string {
+		return match[:strings.Index(match, "@")] + redactedMention
+	})
+}
+
+// NewMentions returns the mentions of the users in curr that were not
+// mentioned in prev, skipping the author.
+func NewMentions(m *Mention, prev, curr []string) []*Mention {
+	known := set.NewSet(prev...)
+	known.Add(m.AuthorId)
+
+	var mentions []*Mention
+
+	for _, id := range curr {
+		if known.Contains(id) {
+			continue
+		}
+
+		mentions = append(mentions, &Mention{
+			UserId:    id,
+			AuthorId:  m.AuthorId,
+			ProjectId: m.ProjectId,
+			TaskId:    m.TaskId,
+			CommentId: m.CommentId,
+		})
+	}
+
+	return mentions
+}
@@ -0,0 +1,76 @@
+package todopb
+
+import (
+	"github.com/rs/xid"
+	"github.com/sladonia/todo-sv/pkg/set"
+)
+
+// IsParticipant reports whether the user is a participant of the project
+// itself.
+func (x *Project) IsParticipant(userID string) bool {
+	return set.NewSet(x.Participants...).Contains(userID)
+}
+
+func (x *Project) IsPendingOwner(userID string) bool {
+	return x.PendingOwnerId != "" && x.PendingOwnerId == userID
+}
+
+// WithoutParticipant removes the participant together with its role.
+func (x *Project) WithoutParticipant(userID string) *Project {
+	updated := x.clone()
+
+	updated.Participants = removeString(updated.Participants, userID)
+	updated.dropStaleRoles()
+	updated.dropStalePendingOwner()
+	updated.Version = xid.New().String()
+	updated.UpdatedAt = timestampNowMilliseconds()
+
+	return updated
+}
+
+// WithPendingOwner offers the ownership to the participant. An empty user id
+// cancels the pending transfer.
+func (x *Project) WithPendingOwner(userID string) *Project {
+	updated := x.clone()
+
+	updated.PendingOwnerId = userID
+	updated.Version = xid.New().String()
+	updated.UpdatedAt = timestampNowMilliseconds()
+
+	return updated
+}
+
+// WithTransferredOwnership makes the pending owner the owner of the project.
+// The previous owner stays a participant.
+func (x *Project) WithTransferredOwnership() *Project {
+	updated := x.clone()
+
+	newOwnerID := updated.PendingOwnerId
+
+	updated.Participants = append(removeString(updated.Participants, newOwnerID), updated.OwnerId)
+	updated.OwnerId = newOwnerID
+	updated.PendingOwnerId = ""
+	updated.dropStaleRoles()
+	updated.Version = xid.New().String()
+	updated.UpdatedAt = timestampNowMilliseconds()
+
+	return updated
+}
+
+func (x *Project) dropStalePendingOwner() {
+	if x.PendingOwnerId != "" && !x.IsParticipant(x.PendingOwnerId) {
+		x.PendingOwnerId = ""
+	}
+}
+
+func removeString(values []string, value string) []string {
+	kept := make([]string, 0, len(values))
+
+	for _, v := range values {
+		if v != value {
+			kept = append(kept, v)
+		}
+	}
+
+	return kept
+}
@@ -0,0 +1,85 @@
+package todopb
+
+import (
+	"sort"
+
+	"github.com/sladonia/todo-sv/pkg/set"
+)
+
+const (
+	UpdatePreferencesPinnedField = "pinned_project_ids"
+	UpdatePreferencesOrderField  = "project_order"
+	UpdatePreferencesHiddenField = "hidden_project_ids"
+)
+
+func NewUserPreferences(userID string) *UserPreferences {
+	return &UserPreferences{UserId: userID}
+}
+
+func (x *UserPreferences) Update(r *UpdateUserPreferencesRequest) *UserPreferences {
+	updated := &UserPreferences{
+		UserId:           x.UserId,
+		PinnedProjectIds: cloneStrings(x.PinnedProjectIds),
+		ProjectOrder:     cloneStrings(x.ProjectOrder),
+		HiddenProjectIds: cloneStrings(x.HiddenProjectIds),
+	}
+
+	paths := r.FieldMask.GetPaths()
+	fieldsSet := set.NewSet(paths...)
+
+	if fieldsSet.Contains(UpdatePreferencesPinnedField) || len(paths) == 0 {
+		updated.PinnedProjectIds = unique(r.PinnedProjectIds)
+	}
+	if fieldsSet.Contains(UpdatePreferencesOrderField) || len(paths) == 0 {
+		updated.ProjectOrder = unique(r.ProjectOrder)
+	}
+	if fieldsSet.Contains(UpdatePreferencesHiddenField) || len(paths) == 0 {
+		updated.HiddenProjectIds = unique(r.HiddenProjectIds)
+	}
+
+	updated.UpdatedAt = timestampNowMilliseconds()
+
+	return updated
+}
+
+// Apply orders the projects by the user's preferences and marks the pinned
+// ones. Pinned projects come first, hidden projects are dropped unless
+// includeHidden is set.
+func (x *UserPreferences) Apply(projects []*Project, includeHidden bool) []*Project {
+	pinned := set.NewSet(x.PinnedProjectIds...)
+	hidden := set.NewSet(x.HiddenProjectIds...)
+
+	position := make(map[string]int, len(x.ProjectOrder))
+	for i, id := range x.ProjectOrder {
+		position[id] = i
+	}
+
+	rank := func(p *Project) int {
+		if pos, ok := position[p.Id]; ok {
+			return pos
+		}
+
+		return len(position)
+	}
+
+	res := make([]*Project, 0, len(projects))
+
+	for _, p := range projects {
+		if hidden.Contains(p.Id) && !includeHidden {
+			continue
+		}
+
+		p.Pinned = pinned.Contains(p.Id)
+		res = append(res, p)
+	}
+
+	sort.SliceStable(res, func(i, j int) bool {
+		if res[i].Pinned != res[j].Pinned {
+			return res[i].Pinned
+		}
+
+		return rank(res[i]) < rank(res[j])
+	})
+
+	return res
+}
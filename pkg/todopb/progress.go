@@ -0,0 +1,83 @@
+package todopb
+
+import (
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// NewProjectProgress computes the project totals and the burndown over the
+// given number of days ending with the day of now. Days are UTC days. Tasks
+// finished before completion time was tracked count as finished at their
+// last update.
+func NewProjectProgress(p *Project, now time.Time, days int) *ProjectProgress {
+	progress := &ProjectProgress{
+		ProjectId: p.Id,
+		Total:     &ProgressTotals{},
+		Finished:  &ProgressTotals{},
+	}
+
+	for _, task := range p.Tasks {
+		progress.Total.add(task)
+
+		if task.IsFinished {
+			progress.Finished.add(task)
+		}
+	}
+
+	today := now.UTC().Truncate(24 * time.Hour)
+
+	for i := days - 1; i >= 0; i-- {
+		day := today.AddDate(0, 0, -i)
+		endOfDay := day.AddDate(0, 0, 1)
+		remaining := &ProgressTotals{}
+
+		for _, task := range p.Tasks {
+			if !task.CreatedAt.AsTime().Before(endOfDay) {
+				continue
+			}
+
+			if finishedAt, ok := task.finishedAt(); ok && finishedAt.Before(endOfDay) {
+				continue
+			}
+
+			remaining.add(task)
+		}
+
+		progress.Burndown = append(progress.Burndown, &BurndownPoint{
+			Date:      timestamppb.New(day),
+			Remaining: remaining,
+		})
+	}
+
+	return progress
+}
+
+func (x *Task) finishedAt() (time.Time, bool) {
+	if !x.IsFinished {
+		return time.Time{}, false
+	}
+
+	if x.FinishedAt != nil {
+		return x.FinishedAt.AsTime(), true
+	}
+
+	return x.UpdatedAt.AsTime(), true
+}
+
+func (x *ProgressTotals) add(task *Task) {
+	x.Tasks++
+	x.Points += task.Estimate.GetPoints()
+	x.Minutes += task.Estimate.GetMinutes()
+}
+
+func (x *Estimate) clone() *Estimate {
+	if x == nil {
+		return nil
+	}
+
+	return &Estimate{
+		Points:  x.Points,
+		Minutes: x.Minutes,
+	}
+}
@@ -1,6 +1,7 @@
 package todopb
 
 import (
+	"sort"
 	"time"
 
 	"github.com/rs/xid"
@@ -12,19 +13,32 @@ const (
 	UpdateProjectNameField         = "name"
 	UpdateProjectOwnerIDField      = "owner_id"
 	UpdateProjectParticipantsField = "participants"
+	UpdateProjectSectionsField     = "sections"
+	UpdateProjectCustomFieldsField = "custom_fields"
+	UpdateProjectStatusesField     = "statuses"
+	UpdateProjectDescriptionField  = "description"
+	UpdateProjectColorField        = "color"
+	UpdateProjectIconField         = "icon"
+	UpdateProjectTeamIDsField      = "team_ids"
 )
 
+// NewProject creates a project without participants, the requested ones are
+// invited.
 func NewProject(r *CreateProjectRequest) *Project {
 	now := timestampNowMilliseconds()
 
 	return &Project{
-		Id:           xid.New().String(),
-		Name:         r.Name,
-		OwnerId:      r.OwnerId,
-		Participants: unique(r.Participants),
-		CreatedAt:    now,
-		UpdatedAt:    now,
-		Version:      xid.New().String(),
+		Id:          xid.New().String(),
+		Name:        r.Name,
+		OwnerId:     r.OwnerId,
+		Description: r.Description,
+		Color:       r.Color,
+		Icon:        r.Icon,
+		ParentId:    r.ParentId,
+		TeamIds:     sortedUnique(r.TeamIds),
+		CreatedAt:   now,
+		UpdatedAt:   now,
+		Version:     xid.New().String(),
 	}
 }
 
@@ -48,20 +62,45 @@ func (x *Project) WithoutTask(taskID string) *Project {
 	return updated
 }
 
+// Update applies the request to the fields listed in its field mask. An empty
+// mask updates the name and the participants only, the fields the request had
+// from the start, so that older clients leave the later ones untouched.
 func (x *Project) Update(r *UpdateProjectRequest) *Project {
 	updated := x.clone()
 
-	fm := r.FieldMask
-	fieldsSet := set.NewSet(fm.Paths...)
+	paths := r.FieldMask.GetPaths()
+	fieldsSet := set.NewSet(paths...)
 
-	if fieldsSet.Contains(UpdateProjectNameField) || len(fm.Paths) == 0 {
+	if fieldsSet.Contains(UpdateProjectNameField) || len(paths) == 0 {
 		updated.Name = r.Name
 	}
-	if fieldsSet.Contains(UpdateProjectOwnerIDField) || len(fm.Paths) == 0 {
-		updated.OwnerId = r.OwnerId
+	if fieldsSet.Contains(UpdateProjectParticipantsField) || len(paths) == 0 {
+		updated.Participants = x.keptParticipants(r.Participants)
+		updated.dropStaleRoles()
+		updated.dropStalePendingOwner()
 	}
-	if fieldsSet.Contains(UpdateProjectParticipantsField) || len(fm.Paths) == 0 {
-		updated.Participants = unique(r.Participants)
+	if fieldsSet.Contains(UpdateProjectSectionsField) {
+		updated.updateSections(r.Sections)
+	}
+	if fieldsSet.Contains(UpdateProjectCustomFieldsField) {
+		updated.CustomFields = cloneCustomFieldDefinitions(r.CustomFields)
+		updated.dropInvalidCustomFields()
+	}
+	if fieldsSet.Contains(UpdateProjectStatusesField) {
+		updated.Statuses = cloneStatuses(r.Statuses)
+		updated.resetUnknownStatuses()
+	}
+	if fieldsSet.Contains(UpdateProjectDescriptionField) {
+		updated.Description = r.Description
+	}
+	if fieldsSet.Contains(UpdateProjectColorField) {
+		updated.Color = r.Color
+	}
+	if fieldsSet.Contains(UpdateProjectIconField) {
+		updated.Icon = r.Icon
+	}
+	if fieldsSet.Contains(UpdateProjectTeamIDsField) {
+		updated.TeamIds = sortedUnique(r.TeamIds)
 	}
 
 	updated.Version = xid.New().String()
@@ -70,6 +109,63 @@ func (x *Project) Update(r *UpdateProjectRequest) *Project {
 	return updated
 }
 
+// UnknownSection returns the id of the first section that does not belong to
+// the project.
+func (x *Project) UnknownSection(sections []*Section) (string, bool) {
+	for _, s := range sections {
+		if _, ok := x.Section(s.Id); !ok {
+			return s.Id, true
+		}
+	}
+
+	return "", false
+}
+
+func (x *Project) updateSections(sections []*Section) {
+	kept := set.NewSet()
+	updated := make([]*Section, 0, len(sections))
+
+	for _, s := range sections {
+		if kept.Contains(s.Id) {
+			continue
+		}
+
+		kept.Add(s.Id)
+		updated = append(updated, s.clone())
+	}
+
+	for _, task := range x.Tasks {
+		if task.SectionId != "" && !kept.Contains(task.SectionId) {
+			task.SectionId = ""
+		}
+	}
+
+	x.Sections = updated
+}
+
+func (x *Project) WithArchived(archived bool) *Project {
+	updated := x.clone()
+
+	updated.Archived = archived
+	updated.Version = xid.New().String()
+	updated.UpdatedAt = timestampNowMilliseconds()
+
+	return updated
+}
+
+// ActiveProjects returns the projects that are not archived.
+func ActiveProjects(projects []*Project) []*Project {
+	active := make([]*Project, 0, len(projects))
+
+	for _, p := range projects {
+		if !p.Archived {
+			active = append(active, p)
+		}
+	}
+
+	return active
+}
+
 func (x *Project) clone() *Project {
 	participants := make([]string, len(x.Participants))
 	copy(participants, x.Participants)
@@ -79,6 +175,11 @@ func (x *Project) clone() *Project {
 		tasks[id] = task.clone()
 	}
 
+	var sections []*Section
+	for _, s := range x.Sections {
+		sections = append(sections, s.clone())
+	}
+
 	createdAt := *x.CreatedAt
 	updatedAt := *x.UpdatedAt
 
@@ -91,6 +192,21 @@ func (x *Project) clone() *Project {
 		CreatedAt:    &createdAt,
 		UpdatedAt:    &updatedAt,
 		Version:      x.Version,
+		Sections:     sections,
+		CustomFields: cloneCustomFieldDefinitions(x.CustomFields),
+		Statuses:     cloneStatuses(x.Statuses),
+		Description:  x.Description,
+		Color:        x.Color,
+		Icon:         x.Icon,
+		Archived:     x.Archived,
+		ParentId:     x.ParentId,
+
+		PendingOwnerId:        x.PendingOwnerId,
+		InheritedParticipants: cloneStrings(x.InheritedParticipants),
+		Roles:                 cloneRoles(x.Roles),
+		InheritedRoles:        cloneRoles(x.InheritedRoles),
+		TeamIds:               cloneStrings(x.TeamIds),
+		TeamMembers:           cloneStrings(x.TeamMembers),
 	}
 }
 
@@ -106,25 +222,116 @@ func (x *Project) TaskList() []*Task {
 	return taskList
 }
 
+// CompletedTasks returns the tasks finished within [from, to).
+func (x *Project) CompletedTasks(from, to time.Time) []*CompletedTask {
+	var completed []*CompletedTask
+
+	for _, task := range x.Tasks {
+		finishedAt, ok := task.finishedAt()
+		if !ok || finishedAt.Before(from) || !finishedAt.Before(to) {
+			continue
+		}
+
+		completed = append(completed, &CompletedTask{
+			ProjectId:   x.Id,
+			ProjectName: x.Name,
+			Task:        task,
+		})
+	}
+
+	return completed
+}
+
+// SortCompletedTasks orders the tasks by the time they were finished.
+func SortCompletedTasks(completed []*CompletedTask) {
+	sort.SliceStable(completed, func(i, j int) bool {
+		left, _ := completed[i].Task.finishedAt()
+		right, _ := completed[j].Task.finishedAt()
+
+		return left.Before(right)
+	})
+}
+
 func (x *Project) ParticipantsIDs() []string {
 	participants := set.NewSet(x.Participants...)
+	participants.Add(x.InheritedParticipants...)
+	participants.Add(x.TeamMembers...)
 	participants.Add(x.OwnerId)
 
 	return participants.Values()
 }
 
-func (x *Project) CanEdit(userID string) bool {
-	if x.OwnerId == userID {
-		return true
+// ChangesOwner reports whether the update request changes the project owner.
+func (x *Project) ChangesOwner(r *UpdateProjectRequest) bool {
+	paths := r.FieldMask.GetPaths()
+	if len(paths) != 0 && !set.NewSet(paths...).Contains(UpdateProjectOwnerIDField) {
+		return false
 	}
 
-	for _, participant := range x.Participants {
-		if participant == userID {
-			return true
+	return r.OwnerId != "" && r.OwnerId != x.OwnerId
+}
+
+// IsMember reports whether the user is the owner or a participant of the
+// project itself.
+func (x *Project) IsMember(userID string) bool {
+	return x.OwnerId == userID || set.NewSet(x.Participants...).Contains(userID)
+}
+
+// InvitedParticipants returns the participants of the update request that are
+// not members yet and have to be invited.
+func (x *Project) InvitedParticipants(r *UpdateProjectRequest) []string {
+	paths := r.FieldMask.GetPaths()
+	if len(paths) != 0 && !set.NewSet(paths...).Contains(UpdateProjectParticipantsField) {
+		return nil
+	}
+
+	return x.NonMembers(r.Participants)
+}
+
+// NonMembers returns the listed users that are not members of the project.
+func (x *Project) NonMembers(userIDs []string) []string {
+	var nonMembers []string
+
+	for _, id := range unique(userIDs) {
+		if !x.IsMember(id) {
+			nonMembers = append(nonMembers, id)
 		}
 	}
 
-	return false
+	sort.Strings(nonMembers)
+
+	return nonMembers
+}
+
+// RemovedParticipants returns the participants that are not participants of
+// the updated project anymore.
+func (x *Project) RemovedParticipants(updated *Project) []string {
+	kept := set.NewSet(updated.Participants...)
+
+	var removed []string
+
+	for _, id := range x.Participants {
+		if !kept.Contains(id) {
+			removed = append(removed, id)
+		}
+	}
+
+	return removed
+}
+
+// keptParticipants returns the current participants that are still listed.
+// Participants are added by accepting invitations only.
+func (x *Project) keptParticipants(participants []string) []string {
+	listed := set.NewSet(participants...)
+	kept := []string{}
+
+	for _, id := range x.Participants {
+		if listed.Contains(id) {
+			kept = append(kept, id)
+		}
+	}
+
+	return kept
 }
 
 func (x *Project) IsOwner(userID string) bool {
@@ -153,6 +360,28 @@ func unique(prev []string) []string {
 	return updated
 }
 
+func sortedUnique(values []string) []string {
+	if len(values) == 0 {
+		return nil
+	}
+
+	sorted := unique(values)
+	sort.Strings(sorted)
+
+	return sorted
+}
+
+func cloneStrings(values []string) []string {
+	if values == nil {
+		return nil
+	}
+
+	cloned := make([]string, len(values))
+	copy(cloned, values)
+
+	return cloned
+}
+
 func timestampNowMilliseconds() *timestamppb.Timestamp {
 	timeNow := time.Now().Round(time.Millisecond)
 	return timestamppb.New(timeNow)
@@ -0,0 +1,130 @@
+package todopb
+
+import (
+	"github.com/rs/xid"
+	"github.com/sladonia/todo-sv/pkg/set"
+)
+
+var roleRanks = map[Role]int{
+	Role_ROLE_VIEWER: 1,
+	Role_ROLE_EDITOR: 2,
+	Role_ROLE_ADMIN:  3,
+	Role_ROLE_OWNER:  4,
+}
+
+// RoleOf returns the role of the user in the project. A user that is a member
+// in several ways, as a participant, an inherited participant or a team
+// member, gets the highest of the roles. Team members are editors.
+func (x *Project) RoleOf(userID string) (Role, bool) {
+	if x.OwnerId == userID {
+		return Role_ROLE_OWNER, true
+	}
+
+	var (
+		role     Role
+		isMember bool
+	)
+
+	if set.NewSet(x.Participants...).Contains(userID) {
+		role, isMember = x.Roles[userID], true
+	}
+
+	if set.NewSet(x.InheritedParticipants...).Contains(userID) {
+		inherited := x.InheritedRoles[userID]
+		if !isMember || roleRanks[inherited] > roleRanks[role] {
+			role = inherited
+		}
+
+		isMember = true
+	}
+
+	if set.NewSet(x.TeamMembers...).Contains(userID) {
+		if !isMember || roleRanks[Role_ROLE_EDITOR] > roleRanks[role] {
+			role = Role_ROLE_EDITOR
+		}
+
+		isMember = true
+	}
+
+	return role, isMember
+}
+
+// ChildRoles returns the roles inherited by the children of the project. The
+// owner of a parent becomes an admin of its children.
+func (x *Project) ChildRoles() map[string]Role {
+	roles := make(map[string]Role)
+
+	for _, id := range x.ParticipantsIDs() {
+		role, _ := x.RoleOf(id)
+		if role == Role_ROLE_OWNER {
+			role = Role_ROLE_ADMIN
+		}
+
+		if role != Role_ROLE_EDITOR {
+			roles[id] = role
+		}
+	}
+
+	return roles
+}
+
+// WithMemberRole sets the role of the participant. Other users are left to
+// be invited.
+func (x *Project) WithMemberRole(memberID string, role Role) *Project {
+	if !set.NewSet(x.Participants...).Contains(memberID) {
+		return x
+	}
+
+	updated := x.clone()
+
+	if updated.Roles == nil {
+		updated.Roles = make(map[string]Role)
+	}
+
+	updated.Roles[memberID] = role
+	if role == Role_ROLE_EDITOR {
+		delete(updated.Roles, memberID)
+	}
+
+	updated.Version = xid.New().String()
+	updated.UpdatedAt = timestampNowMilliseconds()
+
+	return updated
+}
+
+func (x *Project) dropStaleRoles() {
+	participants := set.NewSet(x.Participants...)
+
+	for id := range x.Roles {
+		if !participants.Contains(id) {
+			delete(x.Roles, id)
+		}
+	}
+}
+
+func cloneRoles(roles map[string]Role) map[string]Role {
+	if roles == nil {
+		return nil
+	}
+
+	cloned := make(map[string]Role, len(roles))
+	for id, role := range roles {
+		cloned[id] = role
+	}
+
+	return cloned
+}
+
+func rolesEqual(a, b map[string]Role) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for id, role := range a {
+		if other, ok := b[id]; !ok || other != role {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,132 @@
+package todopb
+
+import (
+	"github.com/rs/xid"
+)
+
+func NewSection(name string) *Section {
+	return &Section{
+		Id:   xid.New().String(),
+		Name: name,
+	}
+}
+
+func (x *Project) Section(sectionID string) (*Section, bool) {
+	for _, s := range x.Sections {
+		if s.Id == sectionID {
+			return s, true
+		}
+	}
+
+	return nil, false
+}
+
+// HasSection reports whether the section exists. An empty sectionID stands for
+// tasks that are not in any section and is always valid.
+func (x *Project) HasSection(sectionID string) bool {
+	if sectionID == "" {
+		return true
+	}
+
+	_, ok := x.Section(sectionID)
+
+	return ok
+}
+
+func (x *Project) SectionTasks(sectionID string) []*Task {
+	var tasks []*Task
+
+	for _, task := range x.Tasks {
+		if task.SectionId == sectionID {
+			tasks = append(tasks, task)
+		}
+	}
+
+	return tasks
+}
+
+func (x *Project) WithSection(section *Section) *Project {
+	updated := x.clone()
+
+	updated.Sections = append(updated.Sections, section.clone())
+	updated.Version = xid.New().String()
+	updated.UpdatedAt = timestampNowMilliseconds()
+
+	return updated
+}
+
+func (x *Project) RenameSection(sectionID, name string) *Project {
+	updated := x.clone()
+
+	for _, s := range updated.Sections {
+		if s.Id == sectionID {
+			s.Name = name
+		}
+	}
+
+	updated.Version = xid.New().String()
+	updated.UpdatedAt = timestampNowMilliseconds()
+
+	return updated
+}
+
+func (x *Project) MoveSection(sectionID string, position int) *Project {
+	updated := x.clone()
+
+	var moved *Section
+	sections := make([]*Section, 0, len(updated.Sections))
+
+	for _, s := range updated.Sections {
+		if s.Id == sectionID {
+			moved = s
+			continue
+		}
+		sections = append(sections, s)
+	}
+
+	if moved != nil {
+		if position > len(sections) {
+			position = len(sections)
+		}
+
+		sections = append(sections[:position], append([]*Section{moved}, sections[position:]...)...)
+	}
+
+	updated.Sections = sections
+	updated.Version = xid.New().String()
+	updated.UpdatedAt = timestampNowMilliseconds()
+
+	return updated
+}
+
+// WithoutSection removes the section and moves its remaining tasks to
+// moveToSectionID.
+func (x *Project) WithoutSection(sectionID, moveToSectionID string) *Project {
+	updated := x.clone()
+
+	sections := make([]*Section, 0, len(updated.Sections))
+	for _, s := range updated.Sections {
+		if s.Id != sectionID {
+			sections = append(sections, s)
+		}
+	}
+
+	for _, task := range updated.Tasks {
+		if task.SectionId == sectionID {
+			task.SectionId = moveToSectionID
+		}
+	}
+
+	updated.Sections = sections
+	updated.Version = xid.New().String()
+	updated.UpdatedAt = timestampNowMilliseconds()
+
+	return updated
+}
+
+func (x *Section) clone() *Section {
+	return &Section{
+		Id:   x.Id,
+		Name: x.Name,
+	}
+}
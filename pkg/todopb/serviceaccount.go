@@ -0,0 +1,119 @@
+package todopb
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"strings"
+
+	"github.com/rs/xid"
+)
+
+const apiKeyPrefix = "tdk_"
+
+func NewServiceAccount(r *CreateServiceAccountRequest) *ServiceAccount {
+	return &ServiceAccount{
+		Id:        xid.New().String(),
+		OwnerId:   r.UserId,
+		Name:      r.Name,
+		CreatedAt: timestampNowMilliseconds(),
+	}
+}
+
+// NewApiKey generates a key for the request. It returns the key description,
+// the key to hand to the client and the hash of its secret to store.
+func NewApiKey(r *CreateApiKeyRequest) (*ApiKey, string, []byte, error) {
+	encodedSecret, err := newSecret()
+	if err != nil {
+		return nil, "", nil, err
+	}
+
+	apiKey := &ApiKey{
+		Id:        xid.New().String(),
+		Name:      r.Name,
+		Scope:     cloneApiKeyScope(r.Scope),
+		CreatedAt: timestampNowMilliseconds(),
+	}
+
+	return apiKey, apiKeyPrefix + apiKey.Id + "_" + encodedSecret, HashApiKeySecret(encodedSecret), nil
+}
+
+// ParseApiKey splits the key into the key id and the secret.
+func ParseApiKey(key string) (string, string, bool) {
+	return parseToken(key, apiKeyPrefix)
+}
+
+func HashApiKeySecret(secret string) []byte {
+	return hashSecret(secret)
+}
+
+// ApiKeySecretMatches compares the secret with the stored hash in constant
+// time.
+func ApiKeySecretMatches(secret string, hash []byte) bool {
+	return secretMatches(secret, hash)
+}
+
+func (x *ServiceAccount) IsOwner(userID string) bool {
+	return x.OwnerId == userID
+}
+
+func (x *ServiceAccount) ApiKey(keyID string) (*ApiKey, bool) {
+	for _, key := range x.ApiKeys {
+		if key.Id == keyID {
+			return key, true
+		}
+	}
+
+	return nil, false
+}
+
+func (x *ApiKey) IsRevoked() bool {
+	return x.RevokedAt != nil
+}
+
+func cloneApiKeyScope(scope *ApiKeyScope) *ApiKeyScope {
+	if scope == nil {
+		return &ApiKeyScope{}
+	}
+
+	return &ApiKeyScope{
+		ProjectIds: unique(scope.ProjectIds),
+		Operations: unique(scope.Operations),
+	}
+}
+
+func newSecret() (string, error) {
+	secret := make([]byte, 32)
+
+	_, err := rand.Read(secret)
+	if err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(secret), nil
+}
+
+// parseToken splits the <prefix><id>_<secret> token into the id and the
+// secret.
+func parseToken(token, prefix string) (string, string, bool) {
+	if !strings.HasPrefix(token, prefix) {
+		return "", "", false
+	}
+
+	id, secret, ok := strings.Cut(strings.TrimPrefix(token, prefix), "_")
+	if !ok || id == "" || secret == "" {
+		return "", "", false
+	}
+
+	return id, secret, true
+}
+
+func hashSecret(secret string) []byte {
+	hash := sha256.Sum256([]byte(secret))
+	return hash[:]
+}
+
+func secretMatches(secret string, hash []byte) bool {
+	return subtle.ConstantTimeCompare(hashSecret(secret), hash) == 1
+}
@@ -0,0 +1,117 @@
+package todopb
+
+import (
+	"sort"
+	"time"
+
+	"github.com/rs/xid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const shareTokenPrefix = "tds_"
+
+// NewShareLink generates a link for the request. It returns the link, the
+// token to hand to the client and the hash of its secret to store.
+func NewShareLink(r *CreateShareLinkRequest, ttl time.Duration) (*ShareLink, string, []byte, error) {
+	secret, err := newSecret()
+	if err != nil {
+		return nil, "", nil, err
+	}
+
+	now := timestampNowMilliseconds()
+
+	link := &ShareLink{
+		Id:        xid.New().String(),
+		ProjectId: r.ProjectId,
+		CreatedBy: r.UserId,
+		CreatedAt: now,
+		ExpiresAt: timestamppb.New(now.AsTime().Add(ttl)),
+	}
+
+	return link, shareTokenPrefix + link.Id + "_" + secret, hashSecret(secret), nil
+}
+
+// ParseShareToken splits the token into the link id and the secret.
+func ParseShareToken(token string) (string, string, bool) {
+	return parseToken(token, shareTokenPrefix)
+}
+
+// ShareSecretMatches compares the secret with the stored hash in constant
+// time.
+func ShareSecretMatches(secret string, hash []byte) bool {
+	return secretMatches(secret, hash)
+}
+
+func (x *ShareLink) IsRevoked() bool {
+	return x.RevokedAt != nil
+}
+
+func (x *ShareLink) IsExpired(now time.Time) bool {
+	return !now.Before(x.ExpiresAt.AsTime())
+}
+
+// IsActive reports whether the link still grants access to the project.
+func (x *ShareLink) IsActive(now time.Time) bool {
+	return !x.IsRevoked() && !x.IsExpired(now)
+}
+
+// Shared returns the read-only projection of the project. Member ids, links
+// to the tasks of other projects and values of user custom fields are left
+// out, mentions in the texts are redacted.
+func (x *Project) Shared() *SharedProject {
+	userFields := make(map[string]bool)
+	for _, f := range x.CustomFields {
+		if f.Type == CustomFieldType_CUSTOM_FIELD_USER {
+			userFields[f.Key] = true
+		}
+	}
+
+	tasks := make([]*Task, 0, len(x.Tasks))
+
+	for _, task := range x.Tasks {
+		shared := task.clone()
+
+		shared.FinishedBy = ""
+		shared.MentionedUserIds = nil
+		shared.Title = RedactMentions(shared.Title)
+		shared.Description = RedactMentions(shared.Description)
+
+		for i := range shared.Attachments {
+			shared.Attachments[i].UploadedBy = ""
+		}
+
+		for key := range shared.CustomFields {
+			if userFields[key] {
+				delete(shared.CustomFields, key)
+			}
+		}
+
+		var blockedBy []*TaskRef
+		for _, ref := range shared.BlockedBy {
+			if ref.ProjectId == x.Id {
+				blockedBy = append(blockedBy, ref)
+			}
+		}
+
+		shared.BlockedBy = blockedBy
+		tasks = append(tasks, shared)
+	}
+
+	sort.Slice(tasks, func(i, j int) bool {
+		return tasks[i].CreatedAt.AsTime().Before(tasks[j].CreatedAt.AsTime())
+	})
+
+	p := x.clone()
+
+	return &SharedProject{
+		Name:         p.Name,
+		Description:  RedactMentions(p.Description),
+		Color:        p.Color,
+		Icon:         p.Icon,
+		Sections:     p.Sections,
+		Statuses:     p.Statuses,
+		CustomFields: p.CustomFields,
+		Tasks:        tasks,
+		UpdatedAt:    p.UpdatedAt,
+	}
+}
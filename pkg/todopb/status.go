@@ -0,0 +1,160 @@
+package todopb
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/sladonia/todo-sv/pkg/set"
+)
+
+const (
+	StatusOpen = "open"
+	StatusDone = "done"
+)
+
+var (
+	ErrUnknownStatus        = errors.New("todopb: unknown task status")
+	ErrTransitionNotAllowed = errors.New("todopb: task status transition not allowed")
+)
+
+// DefaultStatuses is the workflow of projects that did not define their own.
+// It matches the is_finished flag tasks had before statuses were introduced.
+func DefaultStatuses() []*TaskStatus {
+	return []*TaskStatus{
+		{Id: StatusOpen, Name: "Open"},
+		{Id: StatusDone, Name: "Done", IsDone: true},
+	}
+}
+
+// CheckStatuses makes sure the workflow has unique ids, at least one open and
+// one done status, and transitions only to its own statuses.
+func CheckStatuses(statuses []*TaskStatus) error {
+	if len(statuses) == 0 {
+		return nil
+	}
+
+	ids := set.NewSet()
+	hasOpen, hasDone := false, false
+
+	for _, st := range statuses {
+		if ids.Contains(st.Id) {
+			return fmt.Errorf("status %s is defined more than once", st.Id)
+		}
+		ids.Add(st.Id)
+
+		hasOpen = hasOpen || !st.IsDone
+		hasDone = hasDone || st.IsDone
+	}
+
+	if !hasOpen || !hasDone {
+		return errors.New("workflow needs at least one open and one done status")
+	}
+
+	for _, st := range statuses {
+		for _, next := range st.Transitions {
+			if !ids.Contains(next) {
+				return fmt.Errorf("status %s transitions to unknown status %s", st.Id, next)
+			}
+		}
+	}
+
+	return nil
+}
+
+func (x *Project) Workflow() []*TaskStatus {
+	if len(x.Statuses) == 0 {
+		return DefaultStatuses()
+	}
+
+	return x.Statuses
+}
+
+// NewTask creates the task in the first open status of the project workflow.
+func (x *Project) NewTask(r *AddTaskRequest) *Task {
+	task := NewTask(r)
+	task.Status = firstStatus(x.Workflow(), false).Id
+
+	return task
+}
+
+// TaskStatus returns the status of the task. Tasks in a status that is not
+// part of the workflow are considered to be in the first status matching
+// their is_finished flag.
+func (x *Project) TaskStatus(task *Task) *TaskStatus {
+	return effectiveStatus(x.Workflow(), task)
+}
+
+// resetUnknownStatuses moves the tasks in statuses that are no longer part of
+// the workflow to the first status matching their is_finished flag.
+func (x *Project) resetUnknownStatuses() {
+	workflow := x.Workflow()
+
+	for _, task := range x.Tasks {
+		task.Status = effectiveStatus(workflow, task).Id
+	}
+}
+
+func (x *TaskStatus) allows(next *TaskStatus) bool {
+	if x.Id == next.Id || len(x.Transitions) == 0 {
+		return true
+	}
+
+	for _, id := range x.Transitions {
+		if id == next.Id {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (x *TaskStatus) clone() *TaskStatus {
+	transitions := make([]string, len(x.Transitions))
+	copy(transitions, x.Transitions)
+
+	return &TaskStatus{
+		Id:          x.Id,
+		Name:        x.Name,
+		IsDone:      x.IsDone,
+		Transitions: transitions,
+	}
+}
+
+func effectiveStatus(workflow []*TaskStatus, task *Task) *TaskStatus {
+	if st, ok := findStatus(workflow, task.Status); ok {
+		return st
+	}
+
+	return firstStatus(workflow, task.IsFinished)
+}
+
+func findStatus(workflow []*TaskStatus, id string) (*TaskStatus, bool) {
+	for _, st := range workflow {
+		if st.Id == id {
+			return st, true
+		}
+	}
+
+	return nil, false
+}
+
+// firstStatus returns the first done or open status. Workflows are checked to
+// have both, the default one is used as a fallback otherwise.
+func firstStatus(workflow []*TaskStatus, isDone bool) *TaskStatus {
+	for _, st := range workflow {
+		if st.IsDone == isDone {
+			return st
+		}
+	}
+
+	return firstStatus(DefaultStatuses(), isDone)
+}
+
+func cloneStatuses(statuses []*TaskStatus) []*TaskStatus {
+	var cloned []*TaskStatus
+	for _, st := range statuses {
+		cloned = append(cloned, st.clone())
+	}
+
+	return cloned
+}
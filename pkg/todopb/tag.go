@@ -0,0 +1,86 @@
+package todopb
+
+import (
+	"github.com/rs/xid"
+	"github.com/sladonia/todo-sv/pkg/set"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func NewTag(r *UpsertTagRequest) *Tag {
+	return &Tag{
+		Name:        r.Name,
+		OwnerId:     r.UserId,
+		Color:       r.Color,
+		Description: r.Description,
+	}
+}
+
+// ReplaceTags replaces every tag of from with to in all the project tasks. It
+// returns the updated project along with the number of changed tasks. The
+// project is returned as is when no task carries any of the tags.
+func (x *Project) ReplaceTags(from []string, to string) (*Project, int) {
+	fromSet := set.NewSet(from...)
+	updated := x.clone()
+	changed := 0
+
+	for id, task := range updated.Tasks {
+		if !task.hasAnyTag(fromSet) {
+			continue
+		}
+
+		updated.Tasks[id] = task.replaceTags(fromSet, to)
+		changed++
+	}
+
+	if changed == 0 {
+		return x, 0
+	}
+
+	updated.Version = xid.New().String()
+	updated.UpdatedAt = timestampNowMilliseconds()
+
+	return updated, changed
+}
+
+// TagUsage counts the tasks carrying each tag across the projects.
+func TagUsage(projects []*Project) map[string]uint32 {
+	usage := make(map[string]uint32)
+
+	for _, p := range projects {
+		for _, task := range p.Tasks {
+			for _, tag := range task.Tags {
+				usage[tag]++
+			}
+		}
+	}
+
+	return usage
+}
+
+func (x *Task) hasAnyTag(tags *set.Set) bool {
+	for _, tag := range x.Tags {
+		if tags.Contains(tag) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (x *Task) replaceTags(from *set.Set, to string) *Task {
+	updated := x.clone()
+
+	tags := make([]string, 0, len(updated.Tags))
+	for _, tag := range updated.Tags {
+		if from.Contains(tag) {
+			tag = to
+		}
+		tags = append(tags, tag)
+	}
+
+	updated.Tags = unique(tags)
+	updated.Version = xid.New().String()
+	updated.UpdatedAt = timestamppb.Now()
+
+	return updated
+}
@@ -1,35 +1,50 @@
 package todopb
 
 import (
+	"fmt"
+
 	"github.com/rs/xid"
 	"github.com/sladonia/todo-sv/pkg/set"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 const (
-	UpdateTaskTitleField       = "title"
-	UpdateTaskDescriptionField = "description"
-	UpdateTaskIsImportantField = "is_important"
-	UpdateTaskIsFinishedField  = "is_finished"
-	UpdateTaskTagsField        = "tags"
+	UpdateTaskTitleField        = "title"
+	UpdateTaskDescriptionField  = "description"
+	UpdateTaskIsImportantField  = "is_important"
+	UpdateTaskIsFinishedField   = "is_finished"
+	UpdateTaskTagsField         = "tags"
+	UpdateTaskSectionIDField    = "section_id"
+	UpdateTaskDueAtField        = "due_at"
+	UpdateTaskEstimateField     = "estimate"
+	UpdateTaskCustomFieldsField = "custom_fields"
+	UpdateTaskStatusField       = "status"
 )
 
 func NewTask(r *AddTaskRequest) *Task {
 	now := timestamppb.Now()
 
 	return &Task{
-		Id:          xid.New().String(),
-		Title:       r.Title,
-		Description: r.Description,
-		Tags:        unique(r.Tags),
-		IsImportant: r.IsImportant,
-		CreatedAt:   now,
-		UpdatedAt:   now,
-		Version:     xid.New().String(),
+		Id:               xid.New().String(),
+		Title:            r.Title,
+		Description:      r.Description,
+		Tags:             unique(r.Tags),
+		IsImportant:      r.IsImportant,
+		SectionId:        r.SectionId,
+		DueAt:            r.DueAt,
+		Estimate:         r.Estimate.clone(),
+		CreatedAt:        now,
+		CustomFields:     cloneCustomFieldValues(r.CustomFields),
+		MentionedUserIds: ParseMentions(r.Title, r.Description),
+		UpdatedAt:        now,
+		Version:          xid.New().String(),
 	}
 }
 
-func (x *Task) UpdateTask(r *UpdateTaskRequest) *Task {
+// UpdateTask applies the request to the task. Moving the task to another
+// status of the workflow, either with the status or with the is_finished
+// field, has to be allowed by the transitions of its current status.
+func (x *Task) UpdateTask(r *UpdateTaskRequest, workflow []*TaskStatus) (*Task, error) {
 	updated := x.clone()
 
 	fm := r.FieldMask
@@ -57,29 +72,164 @@ func (x *Task) UpdateTask(r *UpdateTaskRequest) *Task {
 	if fieldSet.Contains(UpdateTaskTagsField) || fieldSet.IsEmpty() {
 		updated.Tags = unique(r.Tags)
 	}
+	if fieldSet.Contains(UpdateTaskSectionIDField) || fieldSet.IsEmpty() {
+		updated.SectionId = r.SectionId
+	}
+	if fieldSet.Contains(UpdateTaskDueAtField) || fieldSet.IsEmpty() {
+		updated.DueAt = r.DueAt
+	}
+	if fieldSet.Contains(UpdateTaskEstimateField) || fieldSet.IsEmpty() {
+		updated.Estimate = r.Estimate.clone()
+	}
+	updated.updateCustomFields(
+		fm.GetPaths(),
+		r.CustomFields,
+		fieldSet.Contains(UpdateTaskCustomFieldsField) || fieldSet.IsEmpty(),
+	)
+
+	current := effectiveStatus(workflow, x)
+	next := current
+
+	switch {
+	case fieldSet.Contains(UpdateTaskStatusField) || (fieldSet.IsEmpty() && r.Status != ""):
+		st, ok := findStatus(workflow, r.Status)
+		if !ok {
+			return nil, fmt.Errorf("%w: %s", ErrUnknownStatus, r.Status)
+		}
+		next = st
+	case updated.IsFinished != current.IsDone:
+		next = firstStatus(workflow, updated.IsFinished)
+	}
+
+	if !current.allows(next) {
+		return nil, fmt.Errorf("%w: from %s to %s", ErrTransitionNotAllowed, current.Id, next.Id)
+	}
+
+	updated.Status = next.Id
+	updated.IsFinished = next.IsDone
+	updated.MentionedUserIds = ParseMentions(updated.Title, updated.Description)
+
+	switch {
+	case updated.IsFinished && !x.IsFinished:
+		updated.FinishedAt = timestampNowMilliseconds()
+		updated.FinishedBy = r.UserId
+	case !updated.IsFinished:
+		updated.FinishedAt = nil
+		updated.FinishedBy = ""
+	}
+
+	updated.Version = xid.New().String()
+	updated.UpdatedAt = timestamppb.Now()
+
+	return updated, nil
+}
+
+func (x *Task) WithAttachment(a *Attachment) *Task {
+	updated := x.clone()
+
+	updated.Attachments = append(updated.Attachments, a)
+	updated.Version = xid.New().String()
+	updated.UpdatedAt = timestamppb.Now()
+
+	return updated
+}
+
+func (x *Task) Attachment(attachmentID string) (*Attachment, bool) {
+	for _, a := range x.Attachments {
+		if a.Id == attachmentID {
+			return a, true
+		}
+	}
+
+	return nil, false
+}
+
+func (x *Task) WithBlocker(ref *TaskRef) *Task {
+	updated := x.clone()
+
+	if !updated.IsBlockedBy(ref) {
+		updated.BlockedBy = append(updated.BlockedBy, ref.clone())
+	}
+	updated.Version = xid.New().String()
+	updated.UpdatedAt = timestamppb.Now()
+
+	return updated
+}
+
+func (x *Task) WithoutBlocker(ref *TaskRef) *Task {
+	updated := x.clone()
+
+	blockedBy := make([]*TaskRef, 0, len(updated.BlockedBy))
+	for _, b := range updated.BlockedBy {
+		if !b.Equal(ref) {
+			blockedBy = append(blockedBy, b)
+		}
+	}
 
-	x.Version = xid.New().String()
-	x.UpdatedAt = timestamppb.Now()
+	updated.BlockedBy = blockedBy
+	updated.Version = xid.New().String()
+	updated.UpdatedAt = timestamppb.Now()
 
 	return updated
 }
 
+func (x *Task) IsBlockedBy(ref *TaskRef) bool {
+	for _, b := range x.BlockedBy {
+		if b.Equal(ref) {
+			return true
+		}
+	}
+
+	return false
+}
+
 func (x *Task) clone() *Task {
 	tags := make([]string, len(x.Tags))
 	copy(tags, x.Tags)
 
+	var attachments []*Attachment
+	for _, a := range x.Attachments {
+		attachments = append(attachments, a.clone())
+	}
+
+	var blockedBy []*TaskRef
+	for _, b := range x.BlockedBy {
+		blockedBy = append(blockedBy, b.clone())
+	}
+
+	var dueAt *timestamppb.Timestamp
+	if x.DueAt != nil {
+		dueAt = timestamppb.New(x.DueAt.AsTime())
+	}
+
+	var finishedAt *timestamppb.Timestamp
+	if x.FinishedAt != nil {
+		finishedAt = timestamppb.New(x.FinishedAt.AsTime())
+	}
+
 	createdAt := *x.CreatedAt
 	updatedAt := *x.UpdatedAt
 
 	return &Task{
-		Id:          x.Id,
-		Title:       x.Title,
-		Description: x.Description,
-		Tags:        tags,
-		IsImportant: x.IsImportant,
-		IsFinished:  x.IsFinished,
-		CreatedAt:   &createdAt,
-		UpdatedAt:   &updatedAt,
-		Version:     x.Version,
+		Id:               x.Id,
+		Title:            x.Title,
+		Description:      x.Description,
+		Tags:             tags,
+		IsImportant:      x.IsImportant,
+		IsFinished:       x.IsFinished,
+		CreatedAt:        &createdAt,
+		UpdatedAt:        &updatedAt,
+		Version:          x.Version,
+		Attachments:      attachments,
+		BlockedBy:        blockedBy,
+		IsBlocked:        x.IsBlocked,
+		SectionId:        x.SectionId,
+		DueAt:            dueAt,
+		Estimate:         x.Estimate.clone(),
+		FinishedAt:       finishedAt,
+		FinishedBy:       x.FinishedBy,
+		CustomFields:     cloneCustomFieldValues(x.CustomFields),
+		Status:           x.Status,
+		MentionedUserIds: cloneStrings(x.MentionedUserIds),
 	}
 }
@@ -0,0 +1,16 @@
+package todopb
+
+func NewTaskRef(projectID, taskID string) *TaskRef {
+	return &TaskRef{
+		ProjectId: projectID,
+		TaskId:    taskID,
+	}
+}
+
+func (x *TaskRef) Equal(other *TaskRef) bool {
+	return x.ProjectId == other.ProjectId && x.TaskId == other.TaskId
+}
+
+func (x *TaskRef) clone() *TaskRef {
+	return NewTaskRef(x.ProjectId, x.TaskId)
+}
@@ -0,0 +1,167 @@
+package todopb
+
+import (
+	"sort"
+
+	"github.com/rs/xid"
+	"github.com/sladonia/todo-sv/pkg/set"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	UpdateTeamNameField    = "name"
+	UpdateTeamMembersField = "members"
+)
+
+func NewTeam(r *CreateTeamRequest) *Team {
+	now := timestampNowMilliseconds()
+
+	return &Team{
+		Id:        xid.New().String(),
+		Name:      r.Name,
+		OwnerId:   r.UserId,
+		Members:   teamMembers(r.UserId, r.Members),
+		CreatedAt: now,
+		UpdatedAt: now,
+		Version:   xid.New().String(),
+	}
+}
+
+func (x *Team) Update(r *UpdateTeamRequest) *Team {
+	updated := x.clone()
+
+	paths := r.FieldMask.GetPaths()
+	fieldsSet := set.NewSet(paths...)
+
+	if fieldsSet.Contains(UpdateTeamNameField) || len(paths) == 0 {
+		updated.Name = r.Name
+	}
+	if fieldsSet.Contains(UpdateTeamMembersField) || len(paths) == 0 {
+		updated.Members = teamMembers(x.OwnerId, r.Members)
+	}
+
+	updated.Version = xid.New().String()
+	updated.UpdatedAt = timestampNowMilliseconds()
+
+	return updated
+}
+
+func (x *Team) IsOwner(userID string) bool {
+	return x.OwnerId == userID
+}
+
+func (x *Team) IsMember(userID string) bool {
+	return x.OwnerId == userID || set.NewSet(x.Members...).Contains(userID)
+}
+
+// MemberIDs returns the owner and the members of the team.
+func (x *Team) MemberIDs() []string {
+	return append([]string{x.OwnerId}, x.Members...)
+}
+
+// ChangedMembers returns the users added to or removed from the team by the
+// update.
+func (x *Team) ChangedMembers(updated *Team) []string {
+	prev, curr := set.NewSet(x.Members...), set.NewSet(updated.Members...)
+
+	var changed []string
+
+	for _, id := range updated.Members {
+		if !prev.Contains(id) {
+			changed = append(changed, id)
+		}
+	}
+
+	for _, id := range x.Members {
+		if !curr.Contains(id) {
+			changed = append(changed, id)
+		}
+	}
+
+	sort.Strings(changed)
+
+	return changed
+}
+
+// TeamsMembers returns the members of all the teams.
+func TeamsMembers(teams []*Team) []string {
+	members := set.NewSet()
+
+	for _, team := range teams {
+		members.Add(team.MemberIDs()...)
+	}
+
+	ids := members.Values()
+	sort.Strings(ids)
+
+	return ids
+}
+
+// AddedTeams returns the teams that the project is not shared with yet.
+func (x *Project) AddedTeams(teamIDs []string) []string {
+	current := set.NewSet(x.TeamIds...)
+
+	var added []string
+
+	for _, id := range sortedUnique(teamIDs) {
+		if !current.Contains(id) {
+			added = append(added, id)
+		}
+	}
+
+	return added
+}
+
+// WithTeamMembers returns the project with the given team members and whether
+// they differ from the current ones.
+func (x *Project) WithTeamMembers(members []string) (*Project, bool) {
+	if set.NewSet(x.TeamMembers...).IsEqual(set.NewSet(members...)) {
+		return x, false
+	}
+
+	updated := x.clone()
+
+	updated.TeamMembers = cloneStrings(members)
+	updated.Version = xid.New().String()
+	updated.UpdatedAt = timestampNowMilliseconds()
+
+	return updated, true
+}
+
+// WithoutTeam stops sharing the project with the team. The team members are
+// left to be updated by WithTeamMembers.
+func (x *Project) WithoutTeam(teamID string) *Project {
+	updated := x.clone()
+
+	updated.TeamIds = removeString(updated.TeamIds, teamID)
+	updated.Version = xid.New().String()
+	updated.UpdatedAt = timestampNowMilliseconds()
+
+	return updated
+}
+
+func teamMembers(ownerID string, members []string) []string {
+	ids := make([]string, 0, len(members))
+
+	for _, id := range unique(members) {
+		if id != ownerID {
+			ids = append(ids, id)
+		}
+	}
+
+	sort.Strings(ids)
+
+	return ids
+}
+
+func (x *Team) clone() *Team {
+	return &Team{
+		Id:        x.Id,
+		Name:      x.Name,
+		OwnerId:   x.OwnerId,
+		Members:   cloneStrings(x.Members),
+		CreatedAt: timestamppb.New(x.CreatedAt.AsTime()),
+		UpdatedAt: timestamppb.New(x.UpdatedAt.AsTime()),
+		Version:   x.Version,
+	}
+}
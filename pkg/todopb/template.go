@@ -0,0 +1,111 @@
+package todopb
+
+import (
+	"sort"
+
+	"github.com/rs/xid"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func NewTemplate(r *CreateTemplateRequest) *Template {
+	now := timestampNowMilliseconds()
+
+	tasks := make([]*TemplateTask, 0, len(r.Tasks))
+	for _, t := range r.Tasks {
+		tasks = append(tasks, t.clone())
+	}
+
+	return &Template{
+		Id:        xid.New().String(),
+		Name:      r.Name,
+		OwnerId:   r.OwnerId,
+		Tasks:     tasks,
+		CreatedAt: now,
+		UpdatedAt: now,
+		Version:   xid.New().String(),
+	}
+}
+
+// NewProjectTemplate creates a template out of the project tasks. Tasks are
+// kept in creation order and their due dates become offsets from the project
+// creation time.
+func NewProjectTemplate(p *Project, ownerID, name string) *Template {
+	tasks := p.TaskList()
+	sort.Slice(tasks, func(i, j int) bool {
+		return tasks[i].Id < tasks[j].Id
+	})
+
+	templateTasks := make([]*TemplateTask, 0, len(tasks))
+
+	for _, t := range tasks {
+		templateTask := &TemplateTask{
+			Title:       t.Title,
+			Description: t.Description,
+			Tags:        unique(t.Tags),
+			IsImportant: t.IsImportant,
+		}
+
+		if t.DueAt != nil {
+			templateTask.DueOffset = durationpb.New(t.DueAt.AsTime().Sub(p.CreatedAt.AsTime()))
+		}
+
+		templateTasks = append(templateTasks, templateTask)
+	}
+
+	return NewTemplate(&CreateTemplateRequest{
+		OwnerId: ownerID,
+		Name:    name,
+		Tasks:   templateTasks,
+	})
+}
+
+// NewProject builds a project with fresh ids for the project and every task of
+// the template.
+func (x *Template) NewProject(r *CreateProjectFromTemplateRequest) *Project {
+	p := NewProject(&CreateProjectRequest{
+		Name:    r.Name,
+		OwnerId: r.OwnerId,
+	})
+
+	p.Tasks = make(map[string]*Task, len(x.Tasks))
+
+	for _, t := range x.Tasks {
+		task := p.NewTask(&AddTaskRequest{
+			Title:       t.Title,
+			Description: t.Description,
+			Tags:        t.Tags,
+			IsImportant: t.IsImportant,
+		})
+
+		if t.DueOffset != nil {
+			task.DueAt = timestamppb.New(p.CreatedAt.AsTime().Add(t.DueOffset.AsDuration()))
+		}
+
+		p.Tasks[task.Id] = task
+	}
+
+	return p
+}
+
+func (x *Template) IsOwner(userID string) bool {
+	return x.OwnerId == userID
+}
+
+func (x *TemplateTask) clone() *TemplateTask {
+	tags := make([]string, len(x.Tags))
+	copy(tags, x.Tags)
+
+	var dueOffset *durationpb.Duration
+	if x.DueOffset != nil {
+		dueOffset = durationpb.New(x.DueOffset.AsDuration())
+	}
+
+	return &TemplateTask{
+		Title:       x.Title,
+		Description: x.Description,
+		Tags:        tags,
+		IsImportant: x.IsImportant,
+		DueOffset:   dueOffset,
+	}
+}
@@ -0,0 +1,140 @@
+package todopb
+
+import (
+	"sort"
+	"time"
+
+	"github.com/rs/xid"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func NewRunningTimeEntry(r *StartTimerRequest) *TimeEntry {
+	return &TimeEntry{
+		Id:        xid.New().String(),
+		ProjectId: r.ProjectId,
+		TaskId:    r.TaskId,
+		UserId:    r.UserId,
+		StartedAt: timestampNowMilliseconds(),
+		Note:      r.Note,
+	}
+}
+
+func NewTimeEntry(r *AddTimeEntryRequest) *TimeEntry {
+	return &TimeEntry{
+		Id:        xid.New().String(),
+		ProjectId: r.ProjectId,
+		TaskId:    r.TaskId,
+		UserId:    r.UserId,
+		StartedAt: r.StartedAt,
+		EndedAt:   r.EndedAt,
+		Note:      r.Note,
+	}
+}
+
+func (x *TimeEntry) Stop() *TimeEntry {
+	updated := x.clone()
+
+	updated.EndedAt = timestampNowMilliseconds()
+
+	return updated
+}
+
+func (x *TimeEntry) Update(r *UpdateTimeEntryRequest) *TimeEntry {
+	updated := x.clone()
+
+	updated.StartedAt = r.StartedAt
+	updated.EndedAt = r.EndedAt
+	updated.Note = r.Note
+
+	return updated
+}
+
+func (x *TimeEntry) IsRunning() bool {
+	return x.EndedAt == nil
+}
+
+func (x *TimeEntry) BelongsTo(userID string) bool {
+	return x.UserId == userID
+}
+
+func (x *TimeEntry) Duration() time.Duration {
+	if x.IsRunning() {
+		return 0
+	}
+
+	return x.EndedAt.AsTime().Sub(x.StartedAt.AsTime())
+}
+
+func (x *TimeEntry) clone() *TimeEntry {
+	var endedAt *timestamppb.Timestamp
+	if x.EndedAt != nil {
+		endedAt = timestamppb.New(x.EndedAt.AsTime())
+	}
+
+	return &TimeEntry{
+		Id:        x.Id,
+		ProjectId: x.ProjectId,
+		TaskId:    x.TaskId,
+		UserId:    x.UserId,
+		StartedAt: timestamppb.New(x.StartedAt.AsTime()),
+		EndedAt:   endedAt,
+		Note:      x.Note,
+	}
+}
+
+// NewTimeReportRows aggregates the finished entries by project, tag and user.
+// Tags are taken from the current state of the tasks, entries of tasks that
+// no longer exist are reported with an empty tag.
+func NewTimeReportRows(entries []*TimeEntry, projects []*Project) []*TimeReportRow {
+	type key struct {
+		projectID, tag, userID string
+	}
+
+	tasks := make(map[string]*Task)
+	for _, p := range projects {
+		for _, task := range p.Tasks {
+			tasks[p.Id+"/"+task.Id] = task
+		}
+	}
+
+	totals := make(map[key]time.Duration)
+
+	for _, e := range entries {
+		if e.IsRunning() {
+			continue
+		}
+
+		tags := []string{""}
+		if task, ok := tasks[e.ProjectId+"/"+e.TaskId]; ok && len(task.Tags) > 0 {
+			tags = task.Tags
+		}
+
+		for _, tag := range tags {
+			totals[key{e.ProjectId, tag, e.UserId}] += e.Duration()
+		}
+	}
+
+	rows := make([]*TimeReportRow, 0, len(totals))
+	for k, d := range totals {
+		rows = append(rows, &TimeReportRow{
+			ProjectId: k.projectID,
+			Tag:       k.tag,
+			UserId:    k.userID,
+			Duration:  durationpb.New(d),
+		})
+	}
+
+	sort.Slice(rows, func(i, j int) bool {
+		if rows[i].ProjectId != rows[j].ProjectId {
+			return rows[i].ProjectId < rows[j].ProjectId
+		}
+		if rows[i].Tag != rows[j].Tag {
+			return rows[i].Tag < rows[j].Tag
+		}
+
+		return rows[i].UserId < rows[j].UserId
+	})
+
+	return rows
+}
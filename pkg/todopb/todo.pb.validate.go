@@ -126,6 +126,215 @@ func (m *Task) validate(all bool) error {
 
 	// no validation rules for Version
 
+	for idx, item := range m.GetAttachments() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, TaskValidationError{
+						field:  fmt.Sprintf("Attachments[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, TaskValidationError{
+						field:  fmt.Sprintf("Attachments[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return TaskValidationError{
+					field:  fmt.Sprintf("Attachments[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetBlockedBy() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, TaskValidationError{
+						field:  fmt.Sprintf("BlockedBy[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, TaskValidationError{
+						field:  fmt.Sprintf("BlockedBy[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return TaskValidationError{
+					field:  fmt.Sprintf("BlockedBy[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for IsBlocked
+
+	// no validation rules for SectionId
+
+	if all {
+		switch v := interface{}(m.GetDueAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TaskValidationError{
+					field:  "DueAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TaskValidationError{
+					field:  "DueAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDueAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TaskValidationError{
+				field:  "DueAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetEstimate()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TaskValidationError{
+					field:  "Estimate",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TaskValidationError{
+					field:  "Estimate",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEstimate()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TaskValidationError{
+				field:  "Estimate",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetFinishedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TaskValidationError{
+					field:  "FinishedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TaskValidationError{
+					field:  "FinishedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFinishedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TaskValidationError{
+				field:  "FinishedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	{
+		sorted_keys := make([]string, len(m.GetCustomFields()))
+		i := 0
+		for key := range m.GetCustomFields() {
+			sorted_keys[i] = key
+			i++
+		}
+		sort.Slice(sorted_keys, func(i, j int) bool { return sorted_keys[i] < sorted_keys[j] })
+		for _, key := range sorted_keys {
+			val := m.GetCustomFields()[key]
+			_ = val
+
+			// no validation rules for CustomFields[key]
+
+			if all {
+				switch v := interface{}(val).(type) {
+				case interface{ ValidateAll() error }:
+					if err := v.ValidateAll(); err != nil {
+						errors = append(errors, TaskValidationError{
+							field:  fmt.Sprintf("CustomFields[%v]", key),
+							reason: "embedded message failed validation",
+							cause:  err,
+						})
+					}
+				case interface{ Validate() error }:
+					if err := v.Validate(); err != nil {
+						errors = append(errors, TaskValidationError{
+							field:  fmt.Sprintf("CustomFields[%v]", key),
+							reason: "embedded message failed validation",
+							cause:  err,
+						})
+					}
+				}
+			} else if v, ok := interface{}(val).(interface{ Validate() error }); ok {
+				if err := v.Validate(); err != nil {
+					return TaskValidationError{
+						field:  fmt.Sprintf("CustomFields[%v]", key),
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		}
+	}
+
+	// no validation rules for Status
+
+	// no validation rules for FinishedBy
+
 	if len(errors) > 0 {
 		return TaskMultiError(errors)
 	}
@@ -203,92 +412,13951 @@ var _ interface {
 	ErrorName() string
 } = TaskValidationError{}
 
-// Validate checks the field values on Project with the rules defined in the
+// Validate checks the field values on Estimate with the rules defined in the
 // proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *Project) Validate() error {
+func (m *Estimate) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on Project with the rules defined in the
-// proto definition for this message. If any rules are violated, the result is
-// a list of violation errors wrapped in ProjectMultiError, or nil if none found.
-func (m *Project) ValidateAll() error {
+// ValidateAll checks the field values on Estimate with the rules defined in
+// the proto definition for this message. If any rules are violated, the result
+// is a list of violation errors wrapped in EstimateMultiError, or nil if none
+// found.
+func (m *Estimate) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *Project) validate(all bool) error {
+func (m *Estimate) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
+	// no validation rules for Points
 
-	// no validation rules for Name
+	// no validation rules for Minutes
 
-	// no validation rules for OwnerId
+	if len(errors) > 0 {
+		return EstimateMultiError(errors)
+	}
 
-	{
-		sorted_keys := make([]string, len(m.GetTasks()))
-		i := 0
-		for key := range m.GetTasks() {
-			sorted_keys[i] = key
-			i++
-		}
-		sort.Slice(sorted_keys, func(i, j int) bool { return sorted_keys[i] < sorted_keys[j] })
-		for _, key := range sorted_keys {
-			val := m.GetTasks()[key]
-			_ = val
+	return nil
+}
 
-			// no validation rules for Tasks[key]
+// EstimateMultiError is an error wrapping multiple validation errors returned
+// by Estimate.ValidateAll() if the designated constraints aren't met.
+type EstimateMultiError []error
 
-			if all {
-				switch v := interface{}(val).(type) {
-				case interface{ ValidateAll() error }:
-					if err := v.ValidateAll(); err != nil {
-						errors = append(errors, ProjectValidationError{
-							field:  fmt.Sprintf("Tasks[%v]", key),
-							reason: "embedded message failed validation",
-							cause:  err,
-						})
-					}
-				case interface{ Validate() error }:
-					if err := v.Validate(); err != nil {
-						errors = append(errors, ProjectValidationError{
-							field:  fmt.Sprintf("Tasks[%v]", key),
-							reason: "embedded message failed validation",
-							cause:  err,
-						})
-					}
-				}
-			} else if v, ok := interface{}(val).(interface{ Validate() error }); ok {
-				if err := v.Validate(); err != nil {
-					return ProjectValidationError{
-						field:  fmt.Sprintf("Tasks[%v]", key),
-						reason: "embedded message failed validation",
-						cause:  err,
-					}
-				}
-			}
+// Error returns a concatenation of all the error messages it wraps.
+func (m EstimateMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
 
-		}
+// AllErrors returns a list of validation violation errors.
+func (m EstimateMultiError) AllErrors() []error { return m }
+
+// EstimateValidationError is the validation error returned by
+// Estimate.Validate if the designated constraints aren't met.
+type EstimateValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EstimateValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EstimateValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EstimateValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EstimateValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EstimateValidationError) ErrorName() string { return "EstimateValidationError" }
+
+// Error satisfies the builtin error interface
+func (e EstimateValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
 	}
 
-	if all {
-		switch v := interface{}(m.GetCreatedAt()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, ProjectValidationError{
-					field:  "CreatedAt",
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEstimate.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EstimateValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EstimateValidationError{}
+
+// Validate checks the field values on TaskRef with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *TaskRef) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TaskRef with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in TaskRefMultiError, or nil if none
+// found.
+func (m *TaskRef) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TaskRef) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetProjectId()) < 1 {
+		err := TaskRefValidationError{
+			field:  "ProjectId",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetTaskId()) < 1 {
+		err := TaskRefValidationError{
+			field:  "TaskId",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return TaskRefMultiError(errors)
+	}
+
+	return nil
+}
+
+// TaskRefMultiError is an error wrapping multiple validation errors returned
+// by TaskRef.ValidateAll() if the designated constraints aren't met.
+type TaskRefMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TaskRefMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TaskRefMultiError) AllErrors() []error { return m }
+
+// TaskRefValidationError is the validation error returned by TaskRef.Validate
+// if the designated constraints aren't met.
+type TaskRefValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TaskRefValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TaskRefValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TaskRefValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TaskRefValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TaskRefValidationError) ErrorName() string { return "TaskRefValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TaskRefValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTaskRef.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TaskRefValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TaskRefValidationError{}
+
+// Validate checks the field values on Attachment with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Attachment) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Attachment with the rules defined in
+// the proto definition for this message. If any rules are violated, the result
+// is a list of violation errors wrapped in AttachmentMultiError, or nil if
+// none found.
+func (m *Attachment) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Attachment) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Name
+
+	// no validation rules for ContentType
+
+	// no validation rules for Size
+
+	// no validation rules for Checksum
+
+	// no validation rules for UploadedBy
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AttachmentValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AttachmentValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AttachmentValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return AttachmentMultiError(errors)
+	}
+
+	return nil
+}
+
+// AttachmentMultiError is an error wrapping multiple validation errors
+// returned by Attachment.ValidateAll() if the designated constraints aren't
+// met.
+type AttachmentMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AttachmentMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AttachmentMultiError) AllErrors() []error { return m }
+
+// AttachmentValidationError is the validation error returned by
+// Attachment.Validate if the designated constraints aren't met.
+type AttachmentValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AttachmentValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AttachmentValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AttachmentValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AttachmentValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AttachmentValidationError) ErrorName() string { return "AttachmentValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AttachmentValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAttachment.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AttachmentValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AttachmentValidationError{}
+
+// Validate checks the field values on Project with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Project) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Project with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in ProjectMultiError, or nil if none found.
+func (m *Project) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Project) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Name
+
+	// no validation rules for OwnerId
+
+	{
+		sorted_keys := make([]string, len(m.GetTasks()))
+		i := 0
+		for key := range m.GetTasks() {
+			sorted_keys[i] = key
+			i++
+		}
+		sort.Slice(sorted_keys, func(i, j int) bool { return sorted_keys[i] < sorted_keys[j] })
+		for _, key := range sorted_keys {
+			val := m.GetTasks()[key]
+			_ = val
+
+			// no validation rules for Tasks[key]
+
+			if all {
+				switch v := interface{}(val).(type) {
+				case interface{ ValidateAll() error }:
+					if err := v.ValidateAll(); err != nil {
+						errors = append(errors, ProjectValidationError{
+							field:  fmt.Sprintf("Tasks[%v]", key),
+							reason: "embedded message failed validation",
+							cause:  err,
+						})
+					}
+				case interface{ Validate() error }:
+					if err := v.Validate(); err != nil {
+						errors = append(errors, ProjectValidationError{
+							field:  fmt.Sprintf("Tasks[%v]", key),
+							reason: "embedded message failed validation",
+							cause:  err,
+						})
+					}
+				}
+			} else if v, ok := interface{}(val).(interface{ Validate() error }); ok {
+				if err := v.Validate(); err != nil {
+					return ProjectValidationError{
+						field:  fmt.Sprintf("Tasks[%v]", key),
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ProjectValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ProjectValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ProjectValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ProjectValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ProjectValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ProjectValidationError{
+				field:  "UpdatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Version
+
+	for idx, item := range m.GetSections() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ProjectValidationError{
+						field:  fmt.Sprintf("Sections[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ProjectValidationError{
+						field:  fmt.Sprintf("Sections[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ProjectValidationError{
+					field:  fmt.Sprintf("Sections[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetCustomFields() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ProjectValidationError{
+						field:  fmt.Sprintf("CustomFields[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ProjectValidationError{
+						field:  fmt.Sprintf("CustomFields[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ProjectValidationError{
+					field:  fmt.Sprintf("CustomFields[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetStatuses() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ProjectValidationError{
+						field:  fmt.Sprintf("Statuses[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ProjectValidationError{
+						field:  fmt.Sprintf("Statuses[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ProjectValidationError{
+					field:  fmt.Sprintf("Statuses[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Description
+
+	// no validation rules for Color
+
+	// no validation rules for Icon
+
+	// no validation rules for Archived
+
+	// no validation rules for Pinned
+
+	// no validation rules for ParentId
+
+	// no validation rules for Roles
+
+	// no validation rules for InheritedRoles
+
+	// no validation rules for PendingOwnerId
+
+	if len(errors) > 0 {
+		return ProjectMultiError(errors)
+	}
+
+	return nil
+}
+
+// ProjectMultiError is an error wrapping multiple validation errors returned
+// by Project.ValidateAll() if the designated constraints aren't met.
+type ProjectMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ProjectMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ProjectMultiError) AllErrors() []error { return m }
+
+// ProjectValidationError is the validation error returned by Project.Validate
+// if the designated constraints aren't met.
+type ProjectValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ProjectValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ProjectValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ProjectValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ProjectValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ProjectValidationError) ErrorName() string { return "ProjectValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ProjectValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sProject.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ProjectValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ProjectValidationError{}
+
+// Validate checks the field values on TaskStatus with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *TaskStatus) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TaskStatus with the rules defined in
+// the proto definition for this message. If any rules are violated, the result
+// is a list of violation errors wrapped in TaskStatusMultiError, or nil if
+// none found.
+func (m *TaskStatus) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TaskStatus) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetId()) < 1 {
+		err := TaskStatusValidationError{
+			field:  "Id",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Name
+
+	// no validation rules for IsDone
+
+	if len(errors) > 0 {
+		return TaskStatusMultiError(errors)
+	}
+
+	return nil
+}
+
+// TaskStatusMultiError is an error wrapping multiple validation errors
+// returned by TaskStatus.ValidateAll() if the designated constraints aren't
+// met.
+type TaskStatusMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TaskStatusMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TaskStatusMultiError) AllErrors() []error { return m }
+
+// TaskStatusValidationError is the validation error returned by
+// TaskStatus.Validate if the designated constraints aren't met.
+type TaskStatusValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TaskStatusValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TaskStatusValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TaskStatusValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TaskStatusValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TaskStatusValidationError) ErrorName() string { return "TaskStatusValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TaskStatusValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTaskStatus.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TaskStatusValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TaskStatusValidationError{}
+
+// Validate checks the field values on CustomFieldDefinition with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the first error encountered is returned, or nil if there are no violations.
+func (m *CustomFieldDefinition) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CustomFieldDefinition with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the result is a list of violation errors wrapped in
+// CustomFieldDefinitionMultiError, or nil if none found.
+func (m *CustomFieldDefinition) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CustomFieldDefinition) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetKey()) < 1 {
+		err := CustomFieldDefinitionValidationError{
+			field:  "Key",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Name
+
+	if _, ok := CustomFieldType_name[int32(m.GetType())]; !ok {
+		err := CustomFieldDefinitionValidationError{
+			field:  "Type",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return CustomFieldDefinitionMultiError(errors)
+	}
+
+	return nil
+}
+
+// CustomFieldDefinitionMultiError is an error wrapping multiple validation
+// errors returned by CustomFieldDefinition.ValidateAll() if the designated
+// constraints aren't met.
+type CustomFieldDefinitionMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CustomFieldDefinitionMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CustomFieldDefinitionMultiError) AllErrors() []error { return m }
+
+// CustomFieldDefinitionValidationError is the validation error returned by
+// CustomFieldDefinition.Validate if the designated constraints aren't met.
+type CustomFieldDefinitionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CustomFieldDefinitionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CustomFieldDefinitionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CustomFieldDefinitionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CustomFieldDefinitionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CustomFieldDefinitionValidationError) ErrorName() string {
+	return "CustomFieldDefinitionValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CustomFieldDefinitionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCustomFieldDefinition.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CustomFieldDefinitionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CustomFieldDefinitionValidationError{}
+
+// Validate checks the field values on CustomFieldValue with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *CustomFieldValue) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CustomFieldValue with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the result is a list of violation errors wrapped in
+// CustomFieldValueMultiError, or nil if none found.
+func (m *CustomFieldValue) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CustomFieldValue) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Text
+
+	// no validation rules for Number
+
+	if all {
+		switch v := interface{}(m.GetDate()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CustomFieldValueValidationError{
+					field:  "Date",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CustomFieldValueValidationError{
+					field:  "Date",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDate()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CustomFieldValueValidationError{
+				field:  "Date",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CustomFieldValueMultiError(errors)
+	}
+
+	return nil
+}
+
+// CustomFieldValueMultiError is an error wrapping multiple validation errors
+// returned by CustomFieldValue.ValidateAll() if the designated constraints
+// aren't met.
+type CustomFieldValueMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CustomFieldValueMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CustomFieldValueMultiError) AllErrors() []error { return m }
+
+// CustomFieldValueValidationError is the validation error returned by
+// CustomFieldValue.Validate if the designated constraints aren't met.
+type CustomFieldValueValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CustomFieldValueValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CustomFieldValueValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CustomFieldValueValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CustomFieldValueValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CustomFieldValueValidationError) ErrorName() string {
+	return "CustomFieldValueValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CustomFieldValueValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCustomFieldValue.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CustomFieldValueValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CustomFieldValueValidationError{}
+
+// Validate checks the field values on Section with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Section) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Section with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in SectionMultiError, or nil if none
+// found.
+func (m *Section) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Section) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(m.GetName()) < 1 {
+		err := SectionValidationError{
+			field:  "Name",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return SectionMultiError(errors)
+	}
+
+	return nil
+}
+
+// SectionMultiError is an error wrapping multiple validation errors returned
+// by Section.ValidateAll() if the designated constraints aren't met.
+type SectionMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SectionMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SectionMultiError) AllErrors() []error { return m }
+
+// SectionValidationError is the validation error returned by Section.Validate
+// if the designated constraints aren't met.
+type SectionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SectionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SectionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SectionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SectionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SectionValidationError) ErrorName() string { return "SectionValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SectionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSection.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SectionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SectionValidationError{}
+
+// Validate checks the field values on Template with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Template) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Template with the rules defined in
+// the proto definition for this message. If any rules are violated, the result
+// is a list of violation errors wrapped in TemplateMultiError, or nil if none
+// found.
+func (m *Template) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Template) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Name
+
+	// no validation rules for OwnerId
+
+	for idx, item := range m.GetTasks() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, TemplateValidationError{
+						field:  fmt.Sprintf("Tasks[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, TemplateValidationError{
+						field:  fmt.Sprintf("Tasks[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return TemplateValidationError{
+					field:  fmt.Sprintf("Tasks[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TemplateValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TemplateValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TemplateValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TemplateValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TemplateValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TemplateValidationError{
+				field:  "UpdatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Version
+
+	if len(errors) > 0 {
+		return TemplateMultiError(errors)
+	}
+
+	return nil
+}
+
+// TemplateMultiError is an error wrapping multiple validation errors returned
+// by Template.ValidateAll() if the designated constraints aren't met.
+type TemplateMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TemplateMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TemplateMultiError) AllErrors() []error { return m }
+
+// TemplateValidationError is the validation error returned by
+// Template.Validate if the designated constraints aren't met.
+type TemplateValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TemplateValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TemplateValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TemplateValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TemplateValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TemplateValidationError) ErrorName() string { return "TemplateValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TemplateValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTemplate.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TemplateValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TemplateValidationError{}
+
+// Validate checks the field values on TemplateTask with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *TemplateTask) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TemplateTask with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in TemplateTaskMultiError, or
+// nil if none found.
+func (m *TemplateTask) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TemplateTask) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetTitle()) < 1 {
+		err := TemplateTaskValidationError{
+			field:  "Title",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Description
+
+	// no validation rules for IsImportant
+
+	if all {
+		switch v := interface{}(m.GetDueOffset()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TemplateTaskValidationError{
+					field:  "DueOffset",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TemplateTaskValidationError{
+					field:  "DueOffset",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDueOffset()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TemplateTaskValidationError{
+				field:  "DueOffset",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return TemplateTaskMultiError(errors)
+	}
+
+	return nil
+}
+
+// TemplateTaskMultiError is an error wrapping multiple validation errors
+// returned by TemplateTask.ValidateAll() if the designated constraints aren't
+// met.
+type TemplateTaskMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TemplateTaskMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TemplateTaskMultiError) AllErrors() []error { return m }
+
+// TemplateTaskValidationError is the validation error returned by
+// TemplateTask.Validate if the designated constraints aren't met.
+type TemplateTaskValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TemplateTaskValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TemplateTaskValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TemplateTaskValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TemplateTaskValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TemplateTaskValidationError) ErrorName() string { return "TemplateTaskValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TemplateTaskValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTemplateTask.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TemplateTaskValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TemplateTaskValidationError{}
+
+// Validate checks the field values on Tag with the rules defined in the proto
+// definition for this message. If any rules are violated, the first error
+// encountered is returned, or nil if there are no violations.
+func (m *Tag) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Tag with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in TagMultiError, or nil if none found.
+func (m *Tag) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Tag) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	// no validation rules for OwnerId
+
+	// no validation rules for Color
+
+	// no validation rules for Description
+
+	// no validation rules for UsageCount
+
+	if len(errors) > 0 {
+		return TagMultiError(errors)
+	}
+
+	return nil
+}
+
+// TagMultiError is an error wrapping multiple validation errors returned by
+// Tag.ValidateAll() if the designated constraints aren't met.
+type TagMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TagMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TagMultiError) AllErrors() []error { return m }
+
+// TagValidationError is the validation error returned by Tag.Validate if the
+// designated constraints aren't met.
+type TagValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TagValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TagValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TagValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TagValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TagValidationError) ErrorName() string { return "TagValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TagValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTag.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TagValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TagValidationError{}
+
+// Validate checks the field values on TimeEntry with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *TimeEntry) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TimeEntry with the rules defined in
+// the proto definition for this message. If any rules are violated, the result
+// is a list of violation errors wrapped in TimeEntryMultiError, or nil if none
+// found.
+func (m *TimeEntry) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TimeEntry) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for ProjectId
+
+	// no validation rules for TaskId
+
+	// no validation rules for UserId
+
+	if all {
+		switch v := interface{}(m.GetStartedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TimeEntryValidationError{
+					field:  "StartedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TimeEntryValidationError{
+					field:  "StartedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStartedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TimeEntryValidationError{
+				field:  "StartedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetEndedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TimeEntryValidationError{
+					field:  "EndedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TimeEntryValidationError{
+					field:  "EndedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEndedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TimeEntryValidationError{
+				field:  "EndedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Note
+
+	if len(errors) > 0 {
+		return TimeEntryMultiError(errors)
+	}
+
+	return nil
+}
+
+// TimeEntryMultiError is an error wrapping multiple validation errors returned
+// by TimeEntry.ValidateAll() if the designated constraints aren't met.
+type TimeEntryMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TimeEntryMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TimeEntryMultiError) AllErrors() []error { return m }
+
+// TimeEntryValidationError is the validation error returned by
+// TimeEntry.Validate if the designated constraints aren't met.
+type TimeEntryValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TimeEntryValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TimeEntryValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TimeEntryValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TimeEntryValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TimeEntryValidationError) ErrorName() string { return "TimeEntryValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TimeEntryValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTimeEntry.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TimeEntryValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TimeEntryValidationError{}
+
+// Validate checks the field values on Comment with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Comment) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Comment with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in CommentMultiError, or nil if none
+// found.
+func (m *Comment) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Comment) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for ProjectId
+
+	// no validation rules for TaskId
+
+	// no validation rules for AuthorId
+
+	// no validation rules for Text
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CommentValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CommentValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CommentValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CommentValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CommentValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CommentValidationError{
+				field:  "UpdatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Version
+
+	if len(errors) > 0 {
+		return CommentMultiError(errors)
+	}
+
+	return nil
+}
+
+// CommentMultiError is an error wrapping multiple validation errors returned
+// by Comment.ValidateAll() if the designated constraints aren't met.
+type CommentMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CommentMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CommentMultiError) AllErrors() []error { return m }
+
+// CommentValidationError is the validation error returned by Comment.Validate
+// if the designated constraints aren't met.
+type CommentValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CommentValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CommentValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CommentValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CommentValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CommentValidationError) ErrorName() string { return "CommentValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CommentValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sComment.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CommentValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CommentValidationError{}
+
+// Validate checks the field values on Mention with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Mention) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Mention with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in MentionMultiError, or nil if none
+// found.
+func (m *Mention) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Mention) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	// no validation rules for AuthorId
+
+	// no validation rules for ProjectId
+
+	// no validation rules for TaskId
+
+	// no validation rules for CommentId
+
+	if len(errors) > 0 {
+		return MentionMultiError(errors)
+	}
+
+	return nil
+}
+
+// MentionMultiError is an error wrapping multiple validation errors returned
+// by Mention.ValidateAll() if the designated constraints aren't met.
+type MentionMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MentionMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MentionMultiError) AllErrors() []error { return m }
+
+// MentionValidationError is the validation error returned by Mention.Validate
+// if the designated constraints aren't met.
+type MentionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MentionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MentionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MentionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MentionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MentionValidationError) ErrorName() string { return "MentionValidationError" }
+
+// Error satisfies the builtin error interface
+func (e MentionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMention.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MentionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MentionValidationError{}
+
+// Validate checks the field values on Event with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Event) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Event with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in EventMultiError, or nil if none found.
+func (m *Event) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Event) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Type
+
+	if all {
+		switch v := interface{}(m.GetProject()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, EventValidationError{
+					field:  "Project",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, EventValidationError{
+					field:  "Project",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetProject()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return EventValidationError{
+				field:  "Project",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, EventValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, EventValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return EventValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetComment()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, EventValidationError{
+					field:  "Comment",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, EventValidationError{
+					field:  "Comment",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetComment()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return EventValidationError{
+				field:  "Comment",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetMention()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, EventValidationError{
+					field:  "Mention",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, EventValidationError{
+					field:  "Mention",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMention()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return EventValidationError{
+				field:  "Mention",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetPreferences()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, EventValidationError{
+					field:  "Preferences",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, EventValidationError{
+					field:  "Preferences",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPreferences()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return EventValidationError{
+				field:  "Preferences",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetInvitation()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, EventValidationError{
+					field:  "Invitation",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, EventValidationError{
+					field:  "Invitation",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetInvitation()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return EventValidationError{
+				field:  "Invitation",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetTeam()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, EventValidationError{
+					field:  "Team",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, EventValidationError{
+					field:  "Team",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTeam()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return EventValidationError{
+				field:  "Team",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return EventMultiError(errors)
+	}
+
+	return nil
+}
+
+// EventMultiError is an error wrapping multiple validation errors returned by
+// Event.ValidateAll() if the designated constraints aren't met.
+type EventMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m EventMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m EventMultiError) AllErrors() []error { return m }
+
+// EventValidationError is the validation error returned by Event.Validate if
+// the designated constraints aren't met.
+type EventValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EventValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EventValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EventValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EventValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EventValidationError) ErrorName() string { return "EventValidationError" }
+
+// Error satisfies the builtin error interface
+func (e EventValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEvent.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EventValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EventValidationError{}
+
+// Validate checks the field values on CreateProjectRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateProjectRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateProjectRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateProjectRequestMultiError, or nil if none found.
+func (m *CreateProjectRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateProjectRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetName()) < 1 {
+		err := CreateProjectRequestValidationError{
+			field:  "Name",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetOwnerId()) < 1 {
+		err := CreateProjectRequestValidationError{
+			field:  "OwnerId",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Description
+
+	if len(m.GetColor()) > 32 {
+		err := CreateProjectRequestValidationError{
+			field:  "Color",
+			reason: "value length must be at most 32 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetIcon()) > 64 {
+		err := CreateProjectRequestValidationError{
+			field:  "Icon",
+			reason: "value length must be at most 64 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for ParentId
+
+	if len(errors) > 0 {
+		return CreateProjectRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateProjectRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateProjectRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreateProjectRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateProjectRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateProjectRequestMultiError) AllErrors() []error { return m }
+
+// CreateProjectRequestValidationError is the validation error returned by
+// CreateProjectRequest.Validate if the designated constraints aren't met.
+type CreateProjectRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateProjectRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateProjectRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateProjectRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateProjectRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateProjectRequestValidationError) ErrorName() string {
+	return "CreateProjectRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateProjectRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateProjectRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateProjectRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateProjectRequestValidationError{}
+
+// Validate checks the field values on UpdateProjectRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateProjectRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateProjectRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateProjectRequestMultiError, or nil if none found.
+func (m *UpdateProjectRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateProjectRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetProjectId()) < 1 {
+		err := UpdateProjectRequestValidationError{
+			field:  "ProjectId",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetUserId()) < 1 {
+		err := UpdateProjectRequestValidationError{
+			field:  "UserId",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Name
+
+	// no validation rules for OwnerId
+
+	if all {
+		switch v := interface{}(m.GetFieldMask()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateProjectRequestValidationError{
+					field:  "FieldMask",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateProjectRequestValidationError{
+					field:  "FieldMask",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFieldMask()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateProjectRequestValidationError{
+				field:  "FieldMask",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetSections() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, UpdateProjectRequestValidationError{
+						field:  fmt.Sprintf("Sections[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, UpdateProjectRequestValidationError{
+						field:  fmt.Sprintf("Sections[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return UpdateProjectRequestValidationError{
+					field:  fmt.Sprintf("Sections[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetCustomFields() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, UpdateProjectRequestValidationError{
+						field:  fmt.Sprintf("CustomFields[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, UpdateProjectRequestValidationError{
+						field:  fmt.Sprintf("CustomFields[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return UpdateProjectRequestValidationError{
+					field:  fmt.Sprintf("CustomFields[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetStatuses() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, UpdateProjectRequestValidationError{
+						field:  fmt.Sprintf("Statuses[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, UpdateProjectRequestValidationError{
+						field:  fmt.Sprintf("Statuses[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return UpdateProjectRequestValidationError{
+					field:  fmt.Sprintf("Statuses[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Description
+
+	if len(m.GetColor()) > 32 {
+		err := UpdateProjectRequestValidationError{
+			field:  "Color",
+			reason: "value length must be at most 32 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetIcon()) > 64 {
+		err := UpdateProjectRequestValidationError{
+			field:  "Icon",
+			reason: "value length must be at most 64 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return UpdateProjectRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateProjectRequestMultiError is an error wrapping multiple validation
+// errors returned by UpdateProjectRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateProjectRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateProjectRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateProjectRequestMultiError) AllErrors() []error { return m }
+
+// UpdateProjectRequestValidationError is the validation error returned by
+// UpdateProjectRequest.Validate if the designated constraints aren't met.
+type UpdateProjectRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateProjectRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateProjectRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateProjectRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateProjectRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateProjectRequestValidationError) ErrorName() string {
+	return "UpdateProjectRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateProjectRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateProjectRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateProjectRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateProjectRequestValidationError{}
+
+// Validate checks the field values on AllProjectsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AllProjectsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AllProjectsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AllProjectsRequestMultiError, or nil if none found.
+func (m *AllProjectsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AllProjectsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetUserId()) < 1 {
+		err := AllProjectsRequestValidationError{
+			field:  "UserId",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for IncludeArchived
+
+	// no validation rules for IncludeHidden
+
+	if len(errors) > 0 {
+		return AllProjectsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AllProjectsRequestMultiError is an error wrapping multiple validation errors
+// returned by AllProjectsRequest.ValidateAll() if the designated constraints
+// aren't met.
+type AllProjectsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AllProjectsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AllProjectsRequestMultiError) AllErrors() []error { return m }
+
+// AllProjectsRequestValidationError is the validation error returned by
+// AllProjectsRequest.Validate if the designated constraints aren't met.
+type AllProjectsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AllProjectsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AllProjectsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AllProjectsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AllProjectsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AllProjectsRequestValidationError) ErrorName() string {
+	return "AllProjectsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AllProjectsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAllProjectsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AllProjectsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AllProjectsRequestValidationError{}
+
+// Validate checks the field values on GetProjectRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetProjectRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetProjectRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetProjectRequestMultiError, or nil if none found.
+func (m *GetProjectRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetProjectRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetUserId()) < 1 {
+		err := GetProjectRequestValidationError{
+			field:  "UserId",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetProjectId()) < 1 {
+		err := GetProjectRequestValidationError{
+			field:  "ProjectId",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetProjectRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetProjectRequestMultiError is an error wrapping multiple validation errors
+// returned by GetProjectRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetProjectRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetProjectRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetProjectRequestMultiError) AllErrors() []error { return m }
+
+// GetProjectRequestValidationError is the validation error returned by
+// GetProjectRequest.Validate if the designated constraints aren't met.
+type GetProjectRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetProjectRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetProjectRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetProjectRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetProjectRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetProjectRequestValidationError) ErrorName() string {
+	return "GetProjectRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetProjectRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetProjectRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetProjectRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetProjectRequestValidationError{}
+
+// Validate checks the field values on AllProjectsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AllProjectsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AllProjectsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AllProjectsResponseMultiError, or nil if none found.
+func (m *AllProjectsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AllProjectsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetProjects() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AllProjectsResponseValidationError{
+						field:  fmt.Sprintf("Projects[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AllProjectsResponseValidationError{
+						field:  fmt.Sprintf("Projects[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AllProjectsResponseValidationError{
+					field:  fmt.Sprintf("Projects[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return AllProjectsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// AllProjectsResponseMultiError is an error wrapping multiple validation
+// errors returned by AllProjectsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type AllProjectsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AllProjectsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AllProjectsResponseMultiError) AllErrors() []error { return m }
+
+// AllProjectsResponseValidationError is the validation error returned by
+// AllProjectsResponse.Validate if the designated constraints aren't met.
+type AllProjectsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AllProjectsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AllProjectsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AllProjectsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AllProjectsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AllProjectsResponseValidationError) ErrorName() string {
+	return "AllProjectsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AllProjectsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAllProjectsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AllProjectsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AllProjectsResponseValidationError{}
+
+// Validate checks the field values on AddTaskRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *AddTaskRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AddTaskRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in AddTaskRequestMultiError,
+// or nil if none found.
+func (m *AddTaskRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AddTaskRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetTitle()) < 1 {
+		err := AddTaskRequestValidationError{
+			field:  "Title",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetProjectId()) < 1 {
+		err := AddTaskRequestValidationError{
+			field:  "ProjectId",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetUserId()) < 1 {
+		err := AddTaskRequestValidationError{
+			field:  "UserId",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Description
+
+	// no validation rules for IsImportant
+
+	// no validation rules for SectionId
+
+	if all {
+		switch v := interface{}(m.GetDueAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AddTaskRequestValidationError{
+					field:  "DueAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AddTaskRequestValidationError{
+					field:  "DueAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDueAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AddTaskRequestValidationError{
+				field:  "DueAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetEstimate()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AddTaskRequestValidationError{
+					field:  "Estimate",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AddTaskRequestValidationError{
+					field:  "Estimate",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEstimate()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AddTaskRequestValidationError{
+				field:  "Estimate",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	{
+		sorted_keys := make([]string, len(m.GetCustomFields()))
+		i := 0
+		for key := range m.GetCustomFields() {
+			sorted_keys[i] = key
+			i++
+		}
+		sort.Slice(sorted_keys, func(i, j int) bool { return sorted_keys[i] < sorted_keys[j] })
+		for _, key := range sorted_keys {
+			val := m.GetCustomFields()[key]
+			_ = val
+
+			// no validation rules for CustomFields[key]
+
+			if all {
+				switch v := interface{}(val).(type) {
+				case interface{ ValidateAll() error }:
+					if err := v.ValidateAll(); err != nil {
+						errors = append(errors, AddTaskRequestValidationError{
+							field:  fmt.Sprintf("CustomFields[%v]", key),
+							reason: "embedded message failed validation",
+							cause:  err,
+						})
+					}
+				case interface{ Validate() error }:
+					if err := v.Validate(); err != nil {
+						errors = append(errors, AddTaskRequestValidationError{
+							field:  fmt.Sprintf("CustomFields[%v]", key),
+							reason: "embedded message failed validation",
+							cause:  err,
+						})
+					}
+				}
+			} else if v, ok := interface{}(val).(interface{ Validate() error }); ok {
+				if err := v.Validate(); err != nil {
+					return AddTaskRequestValidationError{
+						field:  fmt.Sprintf("CustomFields[%v]", key),
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		}
+	}
+
+	if len(errors) > 0 {
+		return AddTaskRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AddTaskRequestMultiError is an error wrapping multiple validation errors
+// returned by AddTaskRequest.ValidateAll() if the designated constraints
+// aren't met.
+type AddTaskRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AddTaskRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AddTaskRequestMultiError) AllErrors() []error { return m }
+
+// AddTaskRequestValidationError is the validation error returned by
+// AddTaskRequest.Validate if the designated constraints aren't met.
+type AddTaskRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AddTaskRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AddTaskRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AddTaskRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AddTaskRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AddTaskRequestValidationError) ErrorName() string { return "AddTaskRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AddTaskRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAddTaskRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AddTaskRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AddTaskRequestValidationError{}
+
+// Validate checks the field values on UpdateTaskRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *UpdateTaskRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateTaskRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateTaskRequestMultiError, or nil if none found.
+func (m *UpdateTaskRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateTaskRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetTaskId()) < 1 {
+		err := UpdateTaskRequestValidationError{
+			field:  "TaskId",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetProjectId()) < 1 {
+		err := UpdateTaskRequestValidationError{
+			field:  "ProjectId",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetUserId()) < 1 {
+		err := UpdateTaskRequestValidationError{
+			field:  "UserId",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Title
+
+	// no validation rules for Description
+
+	// no validation rules for IsImportant
+
+	// no validation rules for IsFinished
+
+	if all {
+		switch v := interface{}(m.GetFieldMask()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateTaskRequestValidationError{
+					field:  "FieldMask",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateTaskRequestValidationError{
+					field:  "FieldMask",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFieldMask()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateTaskRequestValidationError{
+				field:  "FieldMask",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for SectionId
+
+	if all {
+		switch v := interface{}(m.GetDueAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateTaskRequestValidationError{
+					field:  "DueAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateTaskRequestValidationError{
+					field:  "DueAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDueAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateTaskRequestValidationError{
+				field:  "DueAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetEstimate()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateTaskRequestValidationError{
+					field:  "Estimate",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateTaskRequestValidationError{
+					field:  "Estimate",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEstimate()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateTaskRequestValidationError{
+				field:  "Estimate",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	{
+		sorted_keys := make([]string, len(m.GetCustomFields()))
+		i := 0
+		for key := range m.GetCustomFields() {
+			sorted_keys[i] = key
+			i++
+		}
+		sort.Slice(sorted_keys, func(i, j int) bool { return sorted_keys[i] < sorted_keys[j] })
+		for _, key := range sorted_keys {
+			val := m.GetCustomFields()[key]
+			_ = val
+
+			// no validation rules for CustomFields[key]
+
+			if all {
+				switch v := interface{}(val).(type) {
+				case interface{ ValidateAll() error }:
+					if err := v.ValidateAll(); err != nil {
+						errors = append(errors, UpdateTaskRequestValidationError{
+							field:  fmt.Sprintf("CustomFields[%v]", key),
+							reason: "embedded message failed validation",
+							cause:  err,
+						})
+					}
+				case interface{ Validate() error }:
+					if err := v.Validate(); err != nil {
+						errors = append(errors, UpdateTaskRequestValidationError{
+							field:  fmt.Sprintf("CustomFields[%v]", key),
+							reason: "embedded message failed validation",
+							cause:  err,
+						})
+					}
+				}
+			} else if v, ok := interface{}(val).(interface{ Validate() error }); ok {
+				if err := v.Validate(); err != nil {
+					return UpdateTaskRequestValidationError{
+						field:  fmt.Sprintf("CustomFields[%v]", key),
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		}
+	}
+
+	// no validation rules for Status
+
+	if len(errors) > 0 {
+		return UpdateTaskRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateTaskRequestMultiError is an error wrapping multiple validation errors
+// returned by UpdateTaskRequest.ValidateAll() if the designated constraints
+// aren't met.
+type UpdateTaskRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateTaskRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateTaskRequestMultiError) AllErrors() []error { return m }
+
+// UpdateTaskRequestValidationError is the validation error returned by
+// UpdateTaskRequest.Validate if the designated constraints aren't met.
+type UpdateTaskRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateTaskRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateTaskRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateTaskRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateTaskRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateTaskRequestValidationError) ErrorName() string {
+	return "UpdateTaskRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateTaskRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateTaskRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateTaskRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateTaskRequestValidationError{}
+
+// Validate checks the field values on DeleteTaskRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *DeleteTaskRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteTaskRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteTaskRequestMultiError, or nil if none found.
+func (m *DeleteTaskRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteTaskRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetProjectId()) < 1 {
+		err := DeleteTaskRequestValidationError{
+			field:  "ProjectId",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetTaskId()) < 1 {
+		err := DeleteTaskRequestValidationError{
+			field:  "TaskId",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetUserId()) < 1 {
+		err := DeleteTaskRequestValidationError{
+			field:  "UserId",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DeleteTaskRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteTaskRequestMultiError is an error wrapping multiple validation errors
+// returned by DeleteTaskRequest.ValidateAll() if the designated constraints
+// aren't met.
+type DeleteTaskRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteTaskRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteTaskRequestMultiError) AllErrors() []error { return m }
+
+// DeleteTaskRequestValidationError is the validation error returned by
+// DeleteTaskRequest.Validate if the designated constraints aren't met.
+type DeleteTaskRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteTaskRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteTaskRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteTaskRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteTaskRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteTaskRequestValidationError) ErrorName() string {
+	return "DeleteTaskRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteTaskRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteTaskRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteTaskRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteTaskRequestValidationError{}
+
+// Validate checks the field values on DeleteProjectRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteProjectRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteProjectRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteProjectRequestMultiError, or nil if none found.
+func (m *DeleteProjectRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteProjectRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetProjectId()) < 1 {
+		err := DeleteProjectRequestValidationError{
+			field:  "ProjectId",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetUserId()) < 1 {
+		err := DeleteProjectRequestValidationError{
+			field:  "UserId",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DeleteProjectRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteProjectRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteProjectRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteProjectRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteProjectRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteProjectRequestMultiError) AllErrors() []error { return m }
+
+// DeleteProjectRequestValidationError is the validation error returned by
+// DeleteProjectRequest.Validate if the designated constraints aren't met.
+type DeleteProjectRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteProjectRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteProjectRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteProjectRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteProjectRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteProjectRequestValidationError) ErrorName() string {
+	return "DeleteProjectRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteProjectRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteProjectRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteProjectRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteProjectRequestValidationError{}
+
+// Validate checks the field values on ProjectsUpdatesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ProjectsUpdatesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ProjectsUpdatesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ProjectsUpdatesRequestMultiError, or nil if none found.
+func (m *ProjectsUpdatesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ProjectsUpdatesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetUserId()) < 1 {
+		err := ProjectsUpdatesRequestValidationError{
+			field:  "UserId",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetDeviceId()) < 1 {
+		err := ProjectsUpdatesRequestValidationError{
+			field:  "DeviceId",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ProjectsUpdatesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ProjectsUpdatesRequestMultiError is an error wrapping multiple validation
+// errors returned by ProjectsUpdatesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ProjectsUpdatesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ProjectsUpdatesRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ProjectsUpdatesRequestMultiError) AllErrors() []error { return m }
+
+// ProjectsUpdatesRequestValidationError is the validation error returned by
+// ProjectsUpdatesRequest.Validate if the designated constraints aren't met.
+type ProjectsUpdatesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ProjectsUpdatesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ProjectsUpdatesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ProjectsUpdatesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ProjectsUpdatesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ProjectsUpdatesRequestValidationError) ErrorName() string {
+	return "ProjectsUpdatesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ProjectsUpdatesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sProjectsUpdatesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ProjectsUpdatesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ProjectsUpdatesRequestValidationError{}
+
+// Validate checks the field values on AddCommentRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *AddCommentRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AddCommentRequest with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the result is a list of violation errors wrapped in
+// AddCommentRequestMultiError, or nil if none found.
+func (m *AddCommentRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AddCommentRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetProjectId()) < 1 {
+		err := AddCommentRequestValidationError{
+			field:  "ProjectId",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetTaskId()) < 1 {
+		err := AddCommentRequestValidationError{
+			field:  "TaskId",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetUserId()) < 1 {
+		err := AddCommentRequestValidationError{
+			field:  "UserId",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetText()) < 1 {
+		err := AddCommentRequestValidationError{
+			field:  "Text",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return AddCommentRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AddCommentRequestMultiError is an error wrapping multiple validation errors
+// returned by AddCommentRequest.ValidateAll() if the designated constraints
+// aren't met.
+type AddCommentRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AddCommentRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AddCommentRequestMultiError) AllErrors() []error { return m }
+
+// AddCommentRequestValidationError is the validation error returned by
+// AddCommentRequest.Validate if the designated constraints aren't met.
+type AddCommentRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AddCommentRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AddCommentRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AddCommentRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AddCommentRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AddCommentRequestValidationError) ErrorName() string {
+	return "AddCommentRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AddCommentRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAddCommentRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AddCommentRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AddCommentRequestValidationError{}
+
+// Validate checks the field values on UpdateCommentRequest with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateCommentRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateCommentRequest with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the result is a list of violation errors wrapped in
+// UpdateCommentRequestMultiError, or nil if none found.
+func (m *UpdateCommentRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateCommentRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetCommentId()) < 1 {
+		err := UpdateCommentRequestValidationError{
+			field:  "CommentId",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetUserId()) < 1 {
+		err := UpdateCommentRequestValidationError{
+			field:  "UserId",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetText()) < 1 {
+		err := UpdateCommentRequestValidationError{
+			field:  "Text",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return UpdateCommentRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateCommentRequestMultiError is an error wrapping multiple validation
+// errors returned by UpdateCommentRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateCommentRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateCommentRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateCommentRequestMultiError) AllErrors() []error { return m }
+
+// UpdateCommentRequestValidationError is the validation error returned by
+// UpdateCommentRequest.Validate if the designated constraints aren't met.
+type UpdateCommentRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateCommentRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateCommentRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateCommentRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateCommentRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateCommentRequestValidationError) ErrorName() string {
+	return "UpdateCommentRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateCommentRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateCommentRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateCommentRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateCommentRequestValidationError{}
+
+// Validate checks the field values on DeleteCommentRequest with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteCommentRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteCommentRequest with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the result is a list of violation errors wrapped in
+// DeleteCommentRequestMultiError, or nil if none found.
+func (m *DeleteCommentRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteCommentRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetCommentId()) < 1 {
+		err := DeleteCommentRequestValidationError{
+			field:  "CommentId",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetUserId()) < 1 {
+		err := DeleteCommentRequestValidationError{
+			field:  "UserId",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DeleteCommentRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteCommentRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteCommentRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteCommentRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteCommentRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteCommentRequestMultiError) AllErrors() []error { return m }
+
+// DeleteCommentRequestValidationError is the validation error returned by
+// DeleteCommentRequest.Validate if the designated constraints aren't met.
+type DeleteCommentRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteCommentRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteCommentRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteCommentRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteCommentRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteCommentRequestValidationError) ErrorName() string {
+	return "DeleteCommentRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteCommentRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteCommentRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteCommentRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteCommentRequestValidationError{}
+
+// Validate checks the field values on ListCommentsRequest with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the first error encountered is returned, or nil if there are no violations.
+func (m *ListCommentsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListCommentsRequest with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the result is a list of violation errors wrapped in
+// ListCommentsRequestMultiError, or nil if none found.
+func (m *ListCommentsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListCommentsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetProjectId()) < 1 {
+		err := ListCommentsRequestValidationError{
+			field:  "ProjectId",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetTaskId()) < 1 {
+		err := ListCommentsRequestValidationError{
+			field:  "TaskId",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetUserId()) < 1 {
+		err := ListCommentsRequestValidationError{
+			field:  "UserId",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetPageSize() > 100 {
+		err := ListCommentsRequestValidationError{
+			field:  "PageSize",
+			reason: "value must be less than or equal to 100",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for PageToken
+
+	if len(errors) > 0 {
+		return ListCommentsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListCommentsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListCommentsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListCommentsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListCommentsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListCommentsRequestMultiError) AllErrors() []error { return m }
+
+// ListCommentsRequestValidationError is the validation error returned by
+// ListCommentsRequest.Validate if the designated constraints aren't met.
+type ListCommentsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListCommentsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListCommentsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListCommentsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListCommentsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListCommentsRequestValidationError) ErrorName() string {
+	return "ListCommentsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListCommentsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListCommentsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListCommentsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListCommentsRequestValidationError{}
+
+// Validate checks the field values on ListCommentsResponse with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the first error encountered is returned, or nil if there are no violations.
+func (m *ListCommentsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListCommentsResponse with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the result is a list of violation errors wrapped in
+// ListCommentsResponseMultiError, or nil if none found.
+func (m *ListCommentsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListCommentsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetComments() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListCommentsResponseValidationError{
+						field:  fmt.Sprintf("Comments[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListCommentsResponseValidationError{
+						field:  fmt.Sprintf("Comments[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListCommentsResponseValidationError{
+					field:  fmt.Sprintf("Comments[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
+
+	if len(errors) > 0 {
+		return ListCommentsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListCommentsResponseMultiError is an error wrapping multiple validation
+// errors returned by ListCommentsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListCommentsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListCommentsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListCommentsResponseMultiError) AllErrors() []error { return m }
+
+// ListCommentsResponseValidationError is the validation error returned by
+// ListCommentsResponse.Validate if the designated constraints aren't met.
+type ListCommentsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListCommentsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListCommentsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListCommentsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListCommentsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListCommentsResponseValidationError) ErrorName() string {
+	return "ListCommentsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListCommentsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListCommentsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListCommentsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListCommentsResponseValidationError{}
+
+// Validate checks the field values on AttachmentMetadata with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the first error encountered is returned, or nil if there are no violations.
+func (m *AttachmentMetadata) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AttachmentMetadata with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the result is a list of violation errors wrapped in
+// AttachmentMetadataMultiError, or nil if none found.
+func (m *AttachmentMetadata) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AttachmentMetadata) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetProjectId()) < 1 {
+		err := AttachmentMetadataValidationError{
+			field:  "ProjectId",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetTaskId()) < 1 {
+		err := AttachmentMetadataValidationError{
+			field:  "TaskId",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetUserId()) < 1 {
+		err := AttachmentMetadataValidationError{
+			field:  "UserId",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetName()) < 1 {
+		err := AttachmentMetadataValidationError{
+			field:  "Name",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetContentType()) < 1 {
+		err := AttachmentMetadataValidationError{
+			field:  "ContentType",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return AttachmentMetadataMultiError(errors)
+	}
+
+	return nil
+}
+
+// AttachmentMetadataMultiError is an error wrapping multiple validation errors
+// returned by AttachmentMetadata.ValidateAll() if the designated constraints
+// aren't met.
+type AttachmentMetadataMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AttachmentMetadataMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AttachmentMetadataMultiError) AllErrors() []error { return m }
+
+// AttachmentMetadataValidationError is the validation error returned by
+// AttachmentMetadata.Validate if the designated constraints aren't met.
+type AttachmentMetadataValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AttachmentMetadataValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AttachmentMetadataValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AttachmentMetadataValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AttachmentMetadataValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AttachmentMetadataValidationError) ErrorName() string {
+	return "AttachmentMetadataValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AttachmentMetadataValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAttachmentMetadata.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AttachmentMetadataValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AttachmentMetadataValidationError{}
+
+// Validate checks the field values on UploadAttachmentRequest with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the first error encountered is returned, or nil if there are no violations.
+func (m *UploadAttachmentRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UploadAttachmentRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UploadAttachmentRequestMultiError, or nil if none found.
+func (m *UploadAttachmentRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UploadAttachmentRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetMetadata()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UploadAttachmentRequestValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UploadAttachmentRequestValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMetadata()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UploadAttachmentRequestValidationError{
+				field:  "Metadata",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Chunk
+
+	if len(errors) > 0 {
+		return UploadAttachmentRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UploadAttachmentRequestMultiError is an error wrapping multiple validation
+// errors returned by UploadAttachmentRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UploadAttachmentRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UploadAttachmentRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UploadAttachmentRequestMultiError) AllErrors() []error { return m }
+
+// UploadAttachmentRequestValidationError is the validation error returned by
+// UploadAttachmentRequest.Validate if the designated constraints aren't met.
+type UploadAttachmentRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UploadAttachmentRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UploadAttachmentRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UploadAttachmentRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UploadAttachmentRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UploadAttachmentRequestValidationError) ErrorName() string {
+	return "UploadAttachmentRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UploadAttachmentRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUploadAttachmentRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UploadAttachmentRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UploadAttachmentRequestValidationError{}
+
+// Validate checks the field values on DownloadAttachmentRequest with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the first error encountered is returned, or nil if there are no violations.
+func (m *DownloadAttachmentRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DownloadAttachmentRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DownloadAttachmentRequestMultiError, or nil if none found.
+func (m *DownloadAttachmentRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DownloadAttachmentRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetProjectId()) < 1 {
+		err := DownloadAttachmentRequestValidationError{
+			field:  "ProjectId",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetTaskId()) < 1 {
+		err := DownloadAttachmentRequestValidationError{
+			field:  "TaskId",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetAttachmentId()) < 1 {
+		err := DownloadAttachmentRequestValidationError{
+			field:  "AttachmentId",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetUserId()) < 1 {
+		err := DownloadAttachmentRequestValidationError{
+			field:  "UserId",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DownloadAttachmentRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DownloadAttachmentRequestMultiError is an error wrapping multiple validation
+// errors returned by DownloadAttachmentRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DownloadAttachmentRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DownloadAttachmentRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DownloadAttachmentRequestMultiError) AllErrors() []error { return m }
+
+// DownloadAttachmentRequestValidationError is the validation error returned by
+// DownloadAttachmentRequest.Validate if the designated constraints aren't met.
+type DownloadAttachmentRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DownloadAttachmentRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DownloadAttachmentRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DownloadAttachmentRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DownloadAttachmentRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DownloadAttachmentRequestValidationError) ErrorName() string {
+	return "DownloadAttachmentRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DownloadAttachmentRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDownloadAttachmentRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DownloadAttachmentRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DownloadAttachmentRequestValidationError{}
+
+// Validate checks the field values on DownloadAttachmentResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *DownloadAttachmentResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DownloadAttachmentResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DownloadAttachmentResponseMultiError, or nil if none found.
+func (m *DownloadAttachmentResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DownloadAttachmentResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetAttachment()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DownloadAttachmentResponseValidationError{
+					field:  "Attachment",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DownloadAttachmentResponseValidationError{
+					field:  "Attachment",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAttachment()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DownloadAttachmentResponseValidationError{
+				field:  "Attachment",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Chunk
+
+	if len(errors) > 0 {
+		return DownloadAttachmentResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DownloadAttachmentResponseMultiError is an error wrapping multiple
+// validation errors returned by DownloadAttachmentResponse.ValidateAll() if
+// the designated constraints aren't met.
+type DownloadAttachmentResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DownloadAttachmentResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DownloadAttachmentResponseMultiError) AllErrors() []error { return m }
+
+// DownloadAttachmentResponseValidationError is the validation error returned
+// by DownloadAttachmentResponse.Validate if the designated constraints aren't
+// met.
+type DownloadAttachmentResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DownloadAttachmentResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DownloadAttachmentResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DownloadAttachmentResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DownloadAttachmentResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DownloadAttachmentResponseValidationError) ErrorName() string {
+	return "DownloadAttachmentResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DownloadAttachmentResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDownloadAttachmentResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DownloadAttachmentResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DownloadAttachmentResponseValidationError{}
+
+// Validate checks the field values on TaskBlockerRequest with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the first error encountered is returned, or nil if there are no violations.
+func (m *TaskBlockerRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TaskBlockerRequest with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the result is a list of violation errors wrapped in
+// TaskBlockerRequestMultiError, or nil if none found.
+func (m *TaskBlockerRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TaskBlockerRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetProjectId()) < 1 {
+		err := TaskBlockerRequestValidationError{
+			field:  "ProjectId",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetTaskId()) < 1 {
+		err := TaskBlockerRequestValidationError{
+			field:  "TaskId",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetUserId()) < 1 {
+		err := TaskBlockerRequestValidationError{
+			field:  "UserId",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetBlocker() == nil {
+		err := TaskBlockerRequestValidationError{
+			field:  "Blocker",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetBlocker()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TaskBlockerRequestValidationError{
+					field:  "Blocker",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TaskBlockerRequestValidationError{
+					field:  "Blocker",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetBlocker()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TaskBlockerRequestValidationError{
+				field:  "Blocker",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return TaskBlockerRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// TaskBlockerRequestMultiError is an error wrapping multiple validation errors
+// returned by TaskBlockerRequest.ValidateAll() if the designated constraints
+// aren't met.
+type TaskBlockerRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TaskBlockerRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TaskBlockerRequestMultiError) AllErrors() []error { return m }
+
+// TaskBlockerRequestValidationError is the validation error returned by
+// TaskBlockerRequest.Validate if the designated constraints aren't met.
+type TaskBlockerRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TaskBlockerRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TaskBlockerRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TaskBlockerRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TaskBlockerRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TaskBlockerRequestValidationError) ErrorName() string {
+	return "TaskBlockerRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e TaskBlockerRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTaskBlockerRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TaskBlockerRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TaskBlockerRequestValidationError{}
+
+// Validate checks the field values on CreateSectionRequest with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the first error encountered is returned, or nil if there are no violations.
+func (m *CreateSectionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateSectionRequest with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the result is a list of violation errors wrapped in
+// CreateSectionRequestMultiError, or nil if none found.
+func (m *CreateSectionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateSectionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetProjectId()) < 1 {
+		err := CreateSectionRequestValidationError{
+			field:  "ProjectId",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetUserId()) < 1 {
+		err := CreateSectionRequestValidationError{
+			field:  "UserId",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetName()) < 1 {
+		err := CreateSectionRequestValidationError{
+			field:  "Name",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return CreateSectionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateSectionRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateSectionRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreateSectionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateSectionRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateSectionRequestMultiError) AllErrors() []error { return m }
+
+// CreateSectionRequestValidationError is the validation error returned by
+// CreateSectionRequest.Validate if the designated constraints aren't met.
+type CreateSectionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateSectionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateSectionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateSectionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateSectionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateSectionRequestValidationError) ErrorName() string {
+	return "CreateSectionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateSectionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateSectionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateSectionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateSectionRequestValidationError{}
+
+// Validate checks the field values on RenameSectionRequest with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the first error encountered is returned, or nil if there are no violations.
+func (m *RenameSectionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RenameSectionRequest with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the result is a list of violation errors wrapped in
+// RenameSectionRequestMultiError, or nil if none found.
+func (m *RenameSectionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RenameSectionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetProjectId()) < 1 {
+		err := RenameSectionRequestValidationError{
+			field:  "ProjectId",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetSectionId()) < 1 {
+		err := RenameSectionRequestValidationError{
+			field:  "SectionId",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetUserId()) < 1 {
+		err := RenameSectionRequestValidationError{
+			field:  "UserId",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetName()) < 1 {
+		err := RenameSectionRequestValidationError{
+			field:  "Name",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return RenameSectionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RenameSectionRequestMultiError is an error wrapping multiple validation
+// errors returned by RenameSectionRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RenameSectionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RenameSectionRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RenameSectionRequestMultiError) AllErrors() []error { return m }
+
+// RenameSectionRequestValidationError is the validation error returned by
+// RenameSectionRequest.Validate if the designated constraints aren't met.
+type RenameSectionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RenameSectionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RenameSectionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RenameSectionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RenameSectionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RenameSectionRequestValidationError) ErrorName() string {
+	return "RenameSectionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RenameSectionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRenameSectionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RenameSectionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RenameSectionRequestValidationError{}
+
+// Validate checks the field values on MoveSectionRequest with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the first error encountered is returned, or nil if there are no violations.
+func (m *MoveSectionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MoveSectionRequest with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the result is a list of violation errors wrapped in
+// MoveSectionRequestMultiError, or nil if none found.
+func (m *MoveSectionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MoveSectionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetProjectId()) < 1 {
+		err := MoveSectionRequestValidationError{
+			field:  "ProjectId",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetSectionId()) < 1 {
+		err := MoveSectionRequestValidationError{
+			field:  "SectionId",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetUserId()) < 1 {
+		err := MoveSectionRequestValidationError{
+			field:  "UserId",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Position
+
+	if len(errors) > 0 {
+		return MoveSectionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// MoveSectionRequestMultiError is an error wrapping multiple validation errors
+// returned by MoveSectionRequest.ValidateAll() if the designated constraints
+// aren't met.
+type MoveSectionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MoveSectionRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MoveSectionRequestMultiError) AllErrors() []error { return m }
+
+// MoveSectionRequestValidationError is the validation error returned by
+// MoveSectionRequest.Validate if the designated constraints aren't met.
+type MoveSectionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MoveSectionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MoveSectionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MoveSectionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MoveSectionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MoveSectionRequestValidationError) ErrorName() string {
+	return "MoveSectionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MoveSectionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMoveSectionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MoveSectionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MoveSectionRequestValidationError{}
+
+// Validate checks the field values on DeleteSectionRequest with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteSectionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteSectionRequest with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the result is a list of violation errors wrapped in
+// DeleteSectionRequestMultiError, or nil if none found.
+func (m *DeleteSectionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteSectionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetProjectId()) < 1 {
+		err := DeleteSectionRequestValidationError{
+			field:  "ProjectId",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetSectionId()) < 1 {
+		err := DeleteSectionRequestValidationError{
+			field:  "SectionId",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetUserId()) < 1 {
+		err := DeleteSectionRequestValidationError{
+			field:  "UserId",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for DeleteTasks
+
+	// no validation rules for MoveToSectionId
+
+	if len(errors) > 0 {
+		return DeleteSectionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteSectionRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteSectionRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteSectionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteSectionRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteSectionRequestMultiError) AllErrors() []error { return m }
+
+// DeleteSectionRequestValidationError is the validation error returned by
+// DeleteSectionRequest.Validate if the designated constraints aren't met.
+type DeleteSectionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteSectionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteSectionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteSectionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteSectionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteSectionRequestValidationError) ErrorName() string {
+	return "DeleteSectionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteSectionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteSectionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteSectionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteSectionRequestValidationError{}
+
+// Validate checks the field values on CreateTemplateRequest with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the first error encountered is returned, or nil if there are no violations.
+func (m *CreateTemplateRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateTemplateRequest with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the result is a list of violation errors wrapped in
+// CreateTemplateRequestMultiError, or nil if none found.
+func (m *CreateTemplateRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateTemplateRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetOwnerId()) < 1 {
+		err := CreateTemplateRequestValidationError{
+			field:  "OwnerId",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetName()) < 1 {
+		err := CreateTemplateRequestValidationError{
+			field:  "Name",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetTasks() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CreateTemplateRequestValidationError{
+						field:  fmt.Sprintf("Tasks[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CreateTemplateRequestValidationError{
+						field:  fmt.Sprintf("Tasks[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CreateTemplateRequestValidationError{
+					field:  fmt.Sprintf("Tasks[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return CreateTemplateRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateTemplateRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateTemplateRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreateTemplateRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateTemplateRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateTemplateRequestMultiError) AllErrors() []error { return m }
+
+// CreateTemplateRequestValidationError is the validation error returned by
+// CreateTemplateRequest.Validate if the designated constraints aren't met.
+type CreateTemplateRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateTemplateRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateTemplateRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateTemplateRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateTemplateRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateTemplateRequestValidationError) ErrorName() string {
+	return "CreateTemplateRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateTemplateRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateTemplateRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateTemplateRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateTemplateRequestValidationError{}
+
+// Validate checks the field values on SaveProjectAsTemplateRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *SaveProjectAsTemplateRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SaveProjectAsTemplateRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SaveProjectAsTemplateRequestMultiError, or nil if none found.
+func (m *SaveProjectAsTemplateRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SaveProjectAsTemplateRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetProjectId()) < 1 {
+		err := SaveProjectAsTemplateRequestValidationError{
+			field:  "ProjectId",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetUserId()) < 1 {
+		err := SaveProjectAsTemplateRequestValidationError{
+			field:  "UserId",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetName()) < 1 {
+		err := SaveProjectAsTemplateRequestValidationError{
+			field:  "Name",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return SaveProjectAsTemplateRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SaveProjectAsTemplateRequestMultiError is an error wrapping multiple
+// validation errors returned by SaveProjectAsTemplateRequest.ValidateAll() if
+// the designated constraints aren't met.
+type SaveProjectAsTemplateRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SaveProjectAsTemplateRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SaveProjectAsTemplateRequestMultiError) AllErrors() []error { return m }
+
+// SaveProjectAsTemplateRequestValidationError is the validation error returned
+// by SaveProjectAsTemplateRequest.Validate if the designated constraints
+// aren't met.
+type SaveProjectAsTemplateRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SaveProjectAsTemplateRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SaveProjectAsTemplateRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SaveProjectAsTemplateRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SaveProjectAsTemplateRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SaveProjectAsTemplateRequestValidationError) ErrorName() string {
+	return "SaveProjectAsTemplateRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SaveProjectAsTemplateRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSaveProjectAsTemplateRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SaveProjectAsTemplateRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SaveProjectAsTemplateRequestValidationError{}
+
+// Validate checks the field values on ListTemplatesRequest with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the first error encountered is returned, or nil if there are no violations.
+func (m *ListTemplatesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListTemplatesRequest with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the result is a list of violation errors wrapped in
+// ListTemplatesRequestMultiError, or nil if none found.
+func (m *ListTemplatesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListTemplatesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetUserId()) < 1 {
+		err := ListTemplatesRequestValidationError{
+			field:  "UserId",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ListTemplatesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListTemplatesRequestMultiError is an error wrapping multiple validation
+// errors returned by ListTemplatesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListTemplatesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListTemplatesRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListTemplatesRequestMultiError) AllErrors() []error { return m }
+
+// ListTemplatesRequestValidationError is the validation error returned by
+// ListTemplatesRequest.Validate if the designated constraints aren't met.
+type ListTemplatesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListTemplatesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListTemplatesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListTemplatesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListTemplatesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListTemplatesRequestValidationError) ErrorName() string {
+	return "ListTemplatesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListTemplatesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListTemplatesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListTemplatesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListTemplatesRequestValidationError{}
+
+// Validate checks the field values on ListTemplatesResponse with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the first error encountered is returned, or nil if there are no violations.
+func (m *ListTemplatesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListTemplatesResponse with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the result is a list of violation errors wrapped in
+// ListTemplatesResponseMultiError, or nil if none found.
+func (m *ListTemplatesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListTemplatesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetTemplates() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListTemplatesResponseValidationError{
+						field:  fmt.Sprintf("Templates[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListTemplatesResponseValidationError{
+						field:  fmt.Sprintf("Templates[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListTemplatesResponseValidationError{
+					field:  fmt.Sprintf("Templates[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListTemplatesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListTemplatesResponseMultiError is an error wrapping multiple validation
+// errors returned by ListTemplatesResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListTemplatesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListTemplatesResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListTemplatesResponseMultiError) AllErrors() []error { return m }
+
+// ListTemplatesResponseValidationError is the validation error returned by
+// ListTemplatesResponse.Validate if the designated constraints aren't met.
+type ListTemplatesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListTemplatesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListTemplatesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListTemplatesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListTemplatesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListTemplatesResponseValidationError) ErrorName() string {
+	return "ListTemplatesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListTemplatesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListTemplatesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListTemplatesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListTemplatesResponseValidationError{}
+
+// Validate checks the field values on DeleteTemplateRequest with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteTemplateRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteTemplateRequest with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the result is a list of violation errors wrapped in
+// DeleteTemplateRequestMultiError, or nil if none found.
+func (m *DeleteTemplateRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteTemplateRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetTemplateId()) < 1 {
+		err := DeleteTemplateRequestValidationError{
+			field:  "TemplateId",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetUserId()) < 1 {
+		err := DeleteTemplateRequestValidationError{
+			field:  "UserId",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DeleteTemplateRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteTemplateRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteTemplateRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteTemplateRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteTemplateRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteTemplateRequestMultiError) AllErrors() []error { return m }
+
+// DeleteTemplateRequestValidationError is the validation error returned by
+// DeleteTemplateRequest.Validate if the designated constraints aren't met.
+type DeleteTemplateRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteTemplateRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteTemplateRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteTemplateRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteTemplateRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteTemplateRequestValidationError) ErrorName() string {
+	return "DeleteTemplateRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteTemplateRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteTemplateRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteTemplateRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteTemplateRequestValidationError{}
+
+// Validate checks the field values on CreateProjectFromTemplateRequest with
+// the rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *CreateProjectFromTemplateRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateProjectFromTemplateRequest with
+// the rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateProjectFromTemplateRequestMultiError, or nil if none found.
+func (m *CreateProjectFromTemplateRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateProjectFromTemplateRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetTemplateId()) < 1 {
+		err := CreateProjectFromTemplateRequestValidationError{
+			field:  "TemplateId",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetOwnerId()) < 1 {
+		err := CreateProjectFromTemplateRequestValidationError{
+			field:  "OwnerId",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetName()) < 1 {
+		err := CreateProjectFromTemplateRequestValidationError{
+			field:  "Name",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return CreateProjectFromTemplateRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateProjectFromTemplateRequestMultiError is an error wrapping multiple
+// validation errors returned by CreateProjectFromTemplateRequest.ValidateAll()
+// if the designated constraints aren't met.
+type CreateProjectFromTemplateRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateProjectFromTemplateRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateProjectFromTemplateRequestMultiError) AllErrors() []error { return m }
+
+// CreateProjectFromTemplateRequestValidationError is the validation error
+// returned by CreateProjectFromTemplateRequest.Validate if the designated
+// constraints aren't met.
+type CreateProjectFromTemplateRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateProjectFromTemplateRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateProjectFromTemplateRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateProjectFromTemplateRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateProjectFromTemplateRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateProjectFromTemplateRequestValidationError) ErrorName() string {
+	return "CreateProjectFromTemplateRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateProjectFromTemplateRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateProjectFromTemplateRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateProjectFromTemplateRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateProjectFromTemplateRequestValidationError{}
+
+// Validate checks the field values on UpsertTagRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *UpsertTagRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpsertTagRequest with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the result is a list of violation errors wrapped in
+// UpsertTagRequestMultiError, or nil if none found.
+func (m *UpsertTagRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpsertTagRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetUserId()) < 1 {
+		err := UpsertTagRequestValidationError{
+			field:  "UserId",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetName()) < 1 {
+		err := UpsertTagRequestValidationError{
+			field:  "Name",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetColor()) > 32 {
+		err := UpsertTagRequestValidationError{
+			field:  "Color",
+			reason: "value length must be at most 32 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Description
+
+	if len(errors) > 0 {
+		return UpsertTagRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpsertTagRequestMultiError is an error wrapping multiple validation errors
+// returned by UpsertTagRequest.ValidateAll() if the designated constraints
+// aren't met.
+type UpsertTagRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpsertTagRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpsertTagRequestMultiError) AllErrors() []error { return m }
+
+// UpsertTagRequestValidationError is the validation error returned by
+// UpsertTagRequest.Validate if the designated constraints aren't met.
+type UpsertTagRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpsertTagRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpsertTagRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpsertTagRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpsertTagRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpsertTagRequestValidationError) ErrorName() string {
+	return "UpsertTagRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpsertTagRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpsertTagRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpsertTagRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpsertTagRequestValidationError{}
+
+// Validate checks the field values on ListTagsRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListTagsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListTagsRequest with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the result is a list of violation errors wrapped in
+// ListTagsRequestMultiError, or nil if none found.
+func (m *ListTagsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListTagsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetUserId()) < 1 {
+		err := ListTagsRequestValidationError{
+			field:  "UserId",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ListTagsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListTagsRequestMultiError is an error wrapping multiple validation errors
+// returned by ListTagsRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ListTagsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListTagsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListTagsRequestMultiError) AllErrors() []error { return m }
+
+// ListTagsRequestValidationError is the validation error returned by
+// ListTagsRequest.Validate if the designated constraints aren't met.
+type ListTagsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListTagsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListTagsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListTagsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListTagsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListTagsRequestValidationError) ErrorName() string {
+	return "ListTagsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListTagsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListTagsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListTagsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListTagsRequestValidationError{}
+
+// Validate checks the field values on ListTagsResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListTagsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListTagsResponse with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the result is a list of violation errors wrapped in
+// ListTagsResponseMultiError, or nil if none found.
+func (m *ListTagsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListTagsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetTags() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListTagsResponseValidationError{
+						field:  fmt.Sprintf("Tags[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListTagsResponseValidationError{
+						field:  fmt.Sprintf("Tags[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListTagsResponseValidationError{
+					field:  fmt.Sprintf("Tags[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListTagsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListTagsResponseMultiError is an error wrapping multiple validation errors
+// returned by ListTagsResponse.ValidateAll() if the designated constraints
+// aren't met.
+type ListTagsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListTagsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListTagsResponseMultiError) AllErrors() []error { return m }
+
+// ListTagsResponseValidationError is the validation error returned by
+// ListTagsResponse.Validate if the designated constraints aren't met.
+type ListTagsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListTagsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListTagsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListTagsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListTagsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListTagsResponseValidationError) ErrorName() string {
+	return "ListTagsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListTagsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListTagsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListTagsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListTagsResponseValidationError{}
+
+// Validate checks the field values on RenameTagRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *RenameTagRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RenameTagRequest with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the result is a list of violation errors wrapped in
+// RenameTagRequestMultiError, or nil if none found.
+func (m *RenameTagRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RenameTagRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetUserId()) < 1 {
+		err := RenameTagRequestValidationError{
+			field:  "UserId",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetName()) < 1 {
+		err := RenameTagRequestValidationError{
+			field:  "Name",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetNewName()) < 1 {
+		err := RenameTagRequestValidationError{
+			field:  "NewName",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return RenameTagRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RenameTagRequestMultiError is an error wrapping multiple validation errors
+// returned by RenameTagRequest.ValidateAll() if the designated constraints
+// aren't met.
+type RenameTagRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RenameTagRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RenameTagRequestMultiError) AllErrors() []error { return m }
+
+// RenameTagRequestValidationError is the validation error returned by
+// RenameTagRequest.Validate if the designated constraints aren't met.
+type RenameTagRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RenameTagRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RenameTagRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RenameTagRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RenameTagRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RenameTagRequestValidationError) ErrorName() string {
+	return "RenameTagRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RenameTagRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRenameTagRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RenameTagRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RenameTagRequestValidationError{}
+
+// Validate checks the field values on MergeTagsRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *MergeTagsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MergeTagsRequest with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the result is a list of violation errors wrapped in
+// MergeTagsRequestMultiError, or nil if none found.
+func (m *MergeTagsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MergeTagsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetUserId()) < 1 {
+		err := MergeTagsRequestValidationError{
+			field:  "UserId",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetTags()) < 1 {
+		err := MergeTagsRequestValidationError{
+			field:  "Tags",
+			reason: "value must contain at least 1 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetTarget()) < 1 {
+		err := MergeTagsRequestValidationError{
+			field:  "Target",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return MergeTagsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// MergeTagsRequestMultiError is an error wrapping multiple validation errors
+// returned by MergeTagsRequest.ValidateAll() if the designated constraints
+// aren't met.
+type MergeTagsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MergeTagsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MergeTagsRequestMultiError) AllErrors() []error { return m }
+
+// MergeTagsRequestValidationError is the validation error returned by
+// MergeTagsRequest.Validate if the designated constraints aren't met.
+type MergeTagsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MergeTagsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MergeTagsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MergeTagsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MergeTagsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MergeTagsRequestValidationError) ErrorName() string {
+	return "MergeTagsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MergeTagsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMergeTagsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MergeTagsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MergeTagsRequestValidationError{}
+
+// Validate checks the field values on RewriteTagsResponse with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the first error encountered is returned, or nil if there are no violations.
+func (m *RewriteTagsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RewriteTagsResponse with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the result is a list of violation errors wrapped in
+// RewriteTagsResponseMultiError, or nil if none found.
+func (m *RewriteTagsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RewriteTagsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UpdatedProjects
+
+	// no validation rules for UpdatedTasks
+
+	if len(errors) > 0 {
+		return RewriteTagsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RewriteTagsResponseMultiError is an error wrapping multiple validation
+// errors returned by RewriteTagsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type RewriteTagsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RewriteTagsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RewriteTagsResponseMultiError) AllErrors() []error { return m }
+
+// RewriteTagsResponseValidationError is the validation error returned by
+// RewriteTagsResponse.Validate if the designated constraints aren't met.
+type RewriteTagsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RewriteTagsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RewriteTagsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RewriteTagsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RewriteTagsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RewriteTagsResponseValidationError) ErrorName() string {
+	return "RewriteTagsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RewriteTagsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRewriteTagsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RewriteTagsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RewriteTagsResponseValidationError{}
+
+// Validate checks the field values on StartTimerRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *StartTimerRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StartTimerRequest with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the result is a list of violation errors wrapped in
+// StartTimerRequestMultiError, or nil if none found.
+func (m *StartTimerRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StartTimerRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetProjectId()) < 1 {
+		err := StartTimerRequestValidationError{
+			field:  "ProjectId",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetTaskId()) < 1 {
+		err := StartTimerRequestValidationError{
+			field:  "TaskId",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetUserId()) < 1 {
+		err := StartTimerRequestValidationError{
+			field:  "UserId",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Note
+
+	if len(errors) > 0 {
+		return StartTimerRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// StartTimerRequestMultiError is an error wrapping multiple validation errors
+// returned by StartTimerRequest.ValidateAll() if the designated constraints
+// aren't met.
+type StartTimerRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StartTimerRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StartTimerRequestMultiError) AllErrors() []error { return m }
+
+// StartTimerRequestValidationError is the validation error returned by
+// StartTimerRequest.Validate if the designated constraints aren't met.
+type StartTimerRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StartTimerRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StartTimerRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StartTimerRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StartTimerRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StartTimerRequestValidationError) ErrorName() string {
+	return "StartTimerRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e StartTimerRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStartTimerRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StartTimerRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StartTimerRequestValidationError{}
+
+// Validate checks the field values on StopTimerRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *StopTimerRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StopTimerRequest with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the result is a list of violation errors wrapped in
+// StopTimerRequestMultiError, or nil if none found.
+func (m *StopTimerRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StopTimerRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetUserId()) < 1 {
+		err := StopTimerRequestValidationError{
+			field:  "UserId",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return StopTimerRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// StopTimerRequestMultiError is an error wrapping multiple validation errors
+// returned by StopTimerRequest.ValidateAll() if the designated constraints
+// aren't met.
+type StopTimerRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StopTimerRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StopTimerRequestMultiError) AllErrors() []error { return m }
+
+// StopTimerRequestValidationError is the validation error returned by
+// StopTimerRequest.Validate if the designated constraints aren't met.
+type StopTimerRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StopTimerRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StopTimerRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StopTimerRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StopTimerRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StopTimerRequestValidationError) ErrorName() string {
+	return "StopTimerRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e StopTimerRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStopTimerRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StopTimerRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StopTimerRequestValidationError{}
+
+// Validate checks the field values on AddTimeEntryRequest with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the first error encountered is returned, or nil if there are no violations.
+func (m *AddTimeEntryRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AddTimeEntryRequest with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the result is a list of violation errors wrapped in
+// AddTimeEntryRequestMultiError, or nil if none found.
+func (m *AddTimeEntryRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AddTimeEntryRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetProjectId()) < 1 {
+		err := AddTimeEntryRequestValidationError{
+			field:  "ProjectId",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetTaskId()) < 1 {
+		err := AddTimeEntryRequestValidationError{
+			field:  "TaskId",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetUserId()) < 1 {
+		err := AddTimeEntryRequestValidationError{
+			field:  "UserId",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetStartedAt() == nil {
+		err := AddTimeEntryRequestValidationError{
+			field:  "StartedAt",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetStartedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AddTimeEntryRequestValidationError{
+					field:  "StartedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AddTimeEntryRequestValidationError{
+					field:  "StartedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStartedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AddTimeEntryRequestValidationError{
+				field:  "StartedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.GetEndedAt() == nil {
+		err := AddTimeEntryRequestValidationError{
+			field:  "EndedAt",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetEndedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AddTimeEntryRequestValidationError{
+					field:  "EndedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AddTimeEntryRequestValidationError{
+					field:  "EndedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEndedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AddTimeEntryRequestValidationError{
+				field:  "EndedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Note
+
+	if len(errors) > 0 {
+		return AddTimeEntryRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AddTimeEntryRequestMultiError is an error wrapping multiple validation
+// errors returned by AddTimeEntryRequest.ValidateAll() if the designated
+// constraints aren't met.
+type AddTimeEntryRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AddTimeEntryRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AddTimeEntryRequestMultiError) AllErrors() []error { return m }
+
+// AddTimeEntryRequestValidationError is the validation error returned by
+// AddTimeEntryRequest.Validate if the designated constraints aren't met.
+type AddTimeEntryRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AddTimeEntryRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AddTimeEntryRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AddTimeEntryRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AddTimeEntryRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AddTimeEntryRequestValidationError) ErrorName() string {
+	return "AddTimeEntryRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AddTimeEntryRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAddTimeEntryRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AddTimeEntryRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AddTimeEntryRequestValidationError{}
+
+// Validate checks the field values on UpdateTimeEntryRequest with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateTimeEntryRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateTimeEntryRequest with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the result is a list of violation errors wrapped in
+// UpdateTimeEntryRequestMultiError, or nil if none found.
+func (m *UpdateTimeEntryRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateTimeEntryRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetEntryId()) < 1 {
+		err := UpdateTimeEntryRequestValidationError{
+			field:  "EntryId",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetUserId()) < 1 {
+		err := UpdateTimeEntryRequestValidationError{
+			field:  "UserId",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetStartedAt() == nil {
+		err := UpdateTimeEntryRequestValidationError{
+			field:  "StartedAt",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetStartedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateTimeEntryRequestValidationError{
+					field:  "StartedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateTimeEntryRequestValidationError{
+					field:  "StartedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStartedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateTimeEntryRequestValidationError{
+				field:  "StartedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.GetEndedAt() == nil {
+		err := UpdateTimeEntryRequestValidationError{
+			field:  "EndedAt",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetEndedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateTimeEntryRequestValidationError{
+					field:  "EndedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateTimeEntryRequestValidationError{
+					field:  "EndedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEndedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateTimeEntryRequestValidationError{
+				field:  "EndedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Note
+
+	if len(errors) > 0 {
+		return UpdateTimeEntryRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateTimeEntryRequestMultiError is an error wrapping multiple validation
+// errors returned by UpdateTimeEntryRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateTimeEntryRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateTimeEntryRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateTimeEntryRequestMultiError) AllErrors() []error { return m }
+
+// UpdateTimeEntryRequestValidationError is the validation error returned by
+// UpdateTimeEntryRequest.Validate if the designated constraints aren't met.
+type UpdateTimeEntryRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateTimeEntryRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateTimeEntryRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateTimeEntryRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateTimeEntryRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateTimeEntryRequestValidationError) ErrorName() string {
+	return "UpdateTimeEntryRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateTimeEntryRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateTimeEntryRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateTimeEntryRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateTimeEntryRequestValidationError{}
+
+// Validate checks the field values on DeleteTimeEntryRequest with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteTimeEntryRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteTimeEntryRequest with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the result is a list of violation errors wrapped in
+// DeleteTimeEntryRequestMultiError, or nil if none found.
+func (m *DeleteTimeEntryRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteTimeEntryRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetEntryId()) < 1 {
+		err := DeleteTimeEntryRequestValidationError{
+			field:  "EntryId",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetUserId()) < 1 {
+		err := DeleteTimeEntryRequestValidationError{
+			field:  "UserId",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DeleteTimeEntryRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteTimeEntryRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteTimeEntryRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteTimeEntryRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteTimeEntryRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteTimeEntryRequestMultiError) AllErrors() []error { return m }
+
+// DeleteTimeEntryRequestValidationError is the validation error returned by
+// DeleteTimeEntryRequest.Validate if the designated constraints aren't met.
+type DeleteTimeEntryRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteTimeEntryRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteTimeEntryRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteTimeEntryRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteTimeEntryRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteTimeEntryRequestValidationError) ErrorName() string {
+	return "DeleteTimeEntryRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteTimeEntryRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteTimeEntryRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteTimeEntryRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteTimeEntryRequestValidationError{}
+
+// Validate checks the field values on TimeReportRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *TimeReportRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TimeReportRequest with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the result is a list of violation errors wrapped in
+// TimeReportRequestMultiError, or nil if none found.
+func (m *TimeReportRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TimeReportRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetUserId()) < 1 {
+		err := TimeReportRequestValidationError{
+			field:  "UserId",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetFrom() == nil {
+		err := TimeReportRequestValidationError{
+			field:  "From",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetFrom()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TimeReportRequestValidationError{
+					field:  "From",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TimeReportRequestValidationError{
+					field:  "From",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFrom()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TimeReportRequestValidationError{
+				field:  "From",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.GetTo() == nil {
+		err := TimeReportRequestValidationError{
+			field:  "To",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetTo()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TimeReportRequestValidationError{
+					field:  "To",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TimeReportRequestValidationError{
+					field:  "To",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTo()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TimeReportRequestValidationError{
+				field:  "To",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if _, ok := TimeReportFormat_name[int32(m.GetFormat())]; !ok {
+		err := TimeReportRequestValidationError{
+			field:  "Format",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return TimeReportRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// TimeReportRequestMultiError is an error wrapping multiple validation errors
+// returned by TimeReportRequest.ValidateAll() if the designated constraints
+// aren't met.
+type TimeReportRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TimeReportRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TimeReportRequestMultiError) AllErrors() []error { return m }
+
+// TimeReportRequestValidationError is the validation error returned by
+// TimeReportRequest.Validate if the designated constraints aren't met.
+type TimeReportRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TimeReportRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TimeReportRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TimeReportRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TimeReportRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TimeReportRequestValidationError) ErrorName() string {
+	return "TimeReportRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e TimeReportRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTimeReportRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TimeReportRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TimeReportRequestValidationError{}
+
+// Validate checks the field values on TimeReportRow with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *TimeReportRow) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TimeReportRow with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in TimeReportRowMultiError, or
+// nil if none found.
+func (m *TimeReportRow) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TimeReportRow) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ProjectId
+
+	// no validation rules for Tag
+
+	// no validation rules for UserId
+
+	if all {
+		switch v := interface{}(m.GetDuration()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TimeReportRowValidationError{
+					field:  "Duration",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TimeReportRowValidationError{
+					field:  "Duration",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDuration()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TimeReportRowValidationError{
+				field:  "Duration",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return TimeReportRowMultiError(errors)
+	}
+
+	return nil
+}
+
+// TimeReportRowMultiError is an error wrapping multiple validation errors
+// returned by TimeReportRow.ValidateAll() if the designated constraints aren't
+// met.
+type TimeReportRowMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TimeReportRowMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TimeReportRowMultiError) AllErrors() []error { return m }
+
+// TimeReportRowValidationError is the validation error returned by
+// TimeReportRow.Validate if the designated constraints aren't met.
+type TimeReportRowValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TimeReportRowValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TimeReportRowValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TimeReportRowValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TimeReportRowValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TimeReportRowValidationError) ErrorName() string { return "TimeReportRowValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TimeReportRowValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTimeReportRow.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TimeReportRowValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TimeReportRowValidationError{}
+
+// Validate checks the field values on TimeReportResponse with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the first error encountered is returned, or nil if there are no violations.
+func (m *TimeReportResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TimeReportResponse with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the result is a list of violation errors wrapped in
+// TimeReportResponseMultiError, or nil if none found.
+func (m *TimeReportResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TimeReportResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetRows() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, TimeReportResponseValidationError{
+						field:  fmt.Sprintf("Rows[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, TimeReportResponseValidationError{
+						field:  fmt.Sprintf("Rows[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return TimeReportResponseValidationError{
+					field:  fmt.Sprintf("Rows[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Csv
+
+	if len(errors) > 0 {
+		return TimeReportResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// TimeReportResponseMultiError is an error wrapping multiple validation errors
+// returned by TimeReportResponse.ValidateAll() if the designated constraints
+// aren't met.
+type TimeReportResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TimeReportResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TimeReportResponseMultiError) AllErrors() []error { return m }
+
+// TimeReportResponseValidationError is the validation error returned by
+// TimeReportResponse.Validate if the designated constraints aren't met.
+type TimeReportResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TimeReportResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TimeReportResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TimeReportResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TimeReportResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TimeReportResponseValidationError) ErrorName() string {
+	return "TimeReportResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e TimeReportResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTimeReportResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TimeReportResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TimeReportResponseValidationError{}
+
+// Validate checks the field values on GetProjectProgressRequest with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the first error encountered is returned, or nil if there are no violations.
+func (m *GetProjectProgressRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetProjectProgressRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetProjectProgressRequestMultiError, or nil if none found.
+func (m *GetProjectProgressRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetProjectProgressRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetProjectId()) < 1 {
+		err := GetProjectProgressRequestValidationError{
+			field:  "ProjectId",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetUserId()) < 1 {
+		err := GetProjectProgressRequestValidationError{
+			field:  "UserId",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetDays() > 366 {
+		err := GetProjectProgressRequestValidationError{
+			field:  "Days",
+			reason: "value must be less than or equal to 366",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetProjectProgressRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetProjectProgressRequestMultiError is an error wrapping multiple validation
+// errors returned by GetProjectProgressRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetProjectProgressRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetProjectProgressRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetProjectProgressRequestMultiError) AllErrors() []error { return m }
+
+// GetProjectProgressRequestValidationError is the validation error returned by
+// GetProjectProgressRequest.Validate if the designated constraints aren't met.
+type GetProjectProgressRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetProjectProgressRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetProjectProgressRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetProjectProgressRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetProjectProgressRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetProjectProgressRequestValidationError) ErrorName() string {
+	return "GetProjectProgressRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetProjectProgressRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetProjectProgressRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetProjectProgressRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetProjectProgressRequestValidationError{}
+
+// Validate checks the field values on ProgressTotals with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ProgressTotals) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ProgressTotals with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ProgressTotalsMultiError, or
+// nil if none found.
+func (m *ProgressTotals) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ProgressTotals) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Tasks
+
+	// no validation rules for Points
+
+	// no validation rules for Minutes
+
+	if len(errors) > 0 {
+		return ProgressTotalsMultiError(errors)
+	}
+
+	return nil
+}
+
+// ProgressTotalsMultiError is an error wrapping multiple validation errors
+// returned by ProgressTotals.ValidateAll() if the designated constraints
+// aren't met.
+type ProgressTotalsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ProgressTotalsMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ProgressTotalsMultiError) AllErrors() []error { return m }
+
+// ProgressTotalsValidationError is the validation error returned by
+// ProgressTotals.Validate if the designated constraints aren't met.
+type ProgressTotalsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ProgressTotalsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ProgressTotalsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ProgressTotalsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ProgressTotalsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ProgressTotalsValidationError) ErrorName() string { return "ProgressTotalsValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ProgressTotalsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sProgressTotals.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ProgressTotalsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ProgressTotalsValidationError{}
+
+// Validate checks the field values on BurndownPoint with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *BurndownPoint) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BurndownPoint with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in BurndownPointMultiError, or
+// nil if none found.
+func (m *BurndownPoint) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BurndownPoint) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetDate()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, BurndownPointValidationError{
+					field:  "Date",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, BurndownPointValidationError{
+					field:  "Date",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDate()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return BurndownPointValidationError{
+				field:  "Date",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetRemaining()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, BurndownPointValidationError{
+					field:  "Remaining",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, BurndownPointValidationError{
+					field:  "Remaining",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRemaining()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return BurndownPointValidationError{
+				field:  "Remaining",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return BurndownPointMultiError(errors)
+	}
+
+	return nil
+}
+
+// BurndownPointMultiError is an error wrapping multiple validation errors
+// returned by BurndownPoint.ValidateAll() if the designated constraints aren't
+// met.
+type BurndownPointMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BurndownPointMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BurndownPointMultiError) AllErrors() []error { return m }
+
+// BurndownPointValidationError is the validation error returned by
+// BurndownPoint.Validate if the designated constraints aren't met.
+type BurndownPointValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BurndownPointValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BurndownPointValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BurndownPointValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BurndownPointValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BurndownPointValidationError) ErrorName() string { return "BurndownPointValidationError" }
+
+// Error satisfies the builtin error interface
+func (e BurndownPointValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBurndownPoint.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BurndownPointValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BurndownPointValidationError{}
+
+// Validate checks the field values on ProjectProgress with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ProjectProgress) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ProjectProgress with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the result is a list of violation errors wrapped in
+// ProjectProgressMultiError, or nil if none found.
+func (m *ProjectProgress) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ProjectProgress) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ProjectId
+
+	if all {
+		switch v := interface{}(m.GetTotal()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ProjectProgressValidationError{
+					field:  "Total",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ProjectProgressValidationError{
+					field:  "Total",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTotal()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ProjectProgressValidationError{
+				field:  "Total",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetFinished()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ProjectProgressValidationError{
+					field:  "Finished",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ProjectProgressValidationError{
+					field:  "Finished",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFinished()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ProjectProgressValidationError{
+				field:  "Finished",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetBurndown() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ProjectProgressValidationError{
+						field:  fmt.Sprintf("Burndown[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ProjectProgressValidationError{
+						field:  fmt.Sprintf("Burndown[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ProjectProgressValidationError{
+					field:  fmt.Sprintf("Burndown[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ProjectProgressMultiError(errors)
+	}
+
+	return nil
+}
+
+// ProjectProgressMultiError is an error wrapping multiple validation errors
+// returned by ProjectProgress.ValidateAll() if the designated constraints
+// aren't met.
+type ProjectProgressMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ProjectProgressMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ProjectProgressMultiError) AllErrors() []error { return m }
+
+// ProjectProgressValidationError is the validation error returned by
+// ProjectProgress.Validate if the designated constraints aren't met.
+type ProjectProgressValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ProjectProgressValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ProjectProgressValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ProjectProgressValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ProjectProgressValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ProjectProgressValidationError) ErrorName() string {
+	return "ProjectProgressValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ProjectProgressValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sProjectProgress.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ProjectProgressValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ProjectProgressValidationError{}
+
+// Validate checks the field values on ListTasksRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListTasksRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListTasksRequest with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the result is a list of violation errors wrapped in
+// ListTasksRequestMultiError, or nil if none found.
+func (m *ListTasksRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListTasksRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetProjectId()) < 1 {
+		err := ListTasksRequestValidationError{
+			field:  "ProjectId",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetUserId()) < 1 {
+		err := ListTasksRequestValidationError{
+			field:  "UserId",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	{
+		sorted_keys := make([]string, len(m.GetCustomFields()))
+		i := 0
+		for key := range m.GetCustomFields() {
+			sorted_keys[i] = key
+			i++
+		}
+		sort.Slice(sorted_keys, func(i, j int) bool { return sorted_keys[i] < sorted_keys[j] })
+		for _, key := range sorted_keys {
+			val := m.GetCustomFields()[key]
+			_ = val
+
+			// no validation rules for CustomFields[key]
+
+			if all {
+				switch v := interface{}(val).(type) {
+				case interface{ ValidateAll() error }:
+					if err := v.ValidateAll(); err != nil {
+						errors = append(errors, ListTasksRequestValidationError{
+							field:  fmt.Sprintf("CustomFields[%v]", key),
+							reason: "embedded message failed validation",
+							cause:  err,
+						})
+					}
+				case interface{ Validate() error }:
+					if err := v.Validate(); err != nil {
+						errors = append(errors, ListTasksRequestValidationError{
+							field:  fmt.Sprintf("CustomFields[%v]", key),
+							reason: "embedded message failed validation",
+							cause:  err,
+						})
+					}
+				}
+			} else if v, ok := interface{}(val).(interface{ Validate() error }); ok {
+				if err := v.Validate(); err != nil {
+					return ListTasksRequestValidationError{
+						field:  fmt.Sprintf("CustomFields[%v]", key),
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		}
+	}
+
+	if len(errors) > 0 {
+		return ListTasksRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListTasksRequestMultiError is an error wrapping multiple validation errors
+// returned by ListTasksRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ListTasksRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListTasksRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListTasksRequestMultiError) AllErrors() []error { return m }
+
+// ListTasksRequestValidationError is the validation error returned by
+// ListTasksRequest.Validate if the designated constraints aren't met.
+type ListTasksRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListTasksRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListTasksRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListTasksRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListTasksRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListTasksRequestValidationError) ErrorName() string {
+	return "ListTasksRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListTasksRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListTasksRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListTasksRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListTasksRequestValidationError{}
+
+// Validate checks the field values on ListTasksResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListTasksResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListTasksResponse with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the result is a list of violation errors wrapped in
+// ListTasksResponseMultiError, or nil if none found.
+func (m *ListTasksResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListTasksResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetTasks() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListTasksResponseValidationError{
+						field:  fmt.Sprintf("Tasks[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListTasksResponseValidationError{
+						field:  fmt.Sprintf("Tasks[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListTasksResponseValidationError{
+					field:  fmt.Sprintf("Tasks[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListTasksResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListTasksResponseMultiError is an error wrapping multiple validation errors
+// returned by ListTasksResponse.ValidateAll() if the designated constraints
+// aren't met.
+type ListTasksResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListTasksResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListTasksResponseMultiError) AllErrors() []error { return m }
+
+// ListTasksResponseValidationError is the validation error returned by
+// ListTasksResponse.Validate if the designated constraints aren't met.
+type ListTasksResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListTasksResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListTasksResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListTasksResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListTasksResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListTasksResponseValidationError) ErrorName() string {
+	return "ListTasksResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListTasksResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListTasksResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListTasksResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListTasksResponseValidationError{}
+
+// Validate checks the field values on ListCompletedTasksRequest with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the first error encountered is returned, or nil if there are no violations.
+func (m *ListCompletedTasksRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListCompletedTasksRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListCompletedTasksRequestMultiError, or nil if none found.
+func (m *ListCompletedTasksRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListCompletedTasksRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetUserId()) < 1 {
+		err := ListCompletedTasksRequestValidationError{
+			field:  "UserId",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetFrom() == nil {
+		err := ListCompletedTasksRequestValidationError{
+			field:  "From",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetFrom()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ListCompletedTasksRequestValidationError{
+					field:  "From",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ListCompletedTasksRequestValidationError{
+					field:  "From",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFrom()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListCompletedTasksRequestValidationError{
+				field:  "From",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.GetTo() == nil {
+		err := ListCompletedTasksRequestValidationError{
+			field:  "To",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetTo()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ListCompletedTasksRequestValidationError{
+					field:  "To",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ListCompletedTasksRequestValidationError{
+					field:  "To",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTo()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListCompletedTasksRequestValidationError{
+				field:  "To",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for ProjectId
+
+	if len(errors) > 0 {
+		return ListCompletedTasksRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListCompletedTasksRequestMultiError is an error wrapping multiple validation
+// errors returned by ListCompletedTasksRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListCompletedTasksRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListCompletedTasksRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListCompletedTasksRequestMultiError) AllErrors() []error { return m }
+
+// ListCompletedTasksRequestValidationError is the validation error returned by
+// ListCompletedTasksRequest.Validate if the designated constraints aren't met.
+type ListCompletedTasksRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListCompletedTasksRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListCompletedTasksRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListCompletedTasksRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListCompletedTasksRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListCompletedTasksRequestValidationError) ErrorName() string {
+	return "ListCompletedTasksRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListCompletedTasksRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListCompletedTasksRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListCompletedTasksRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListCompletedTasksRequestValidationError{}
+
+// Validate checks the field values on CompletedTask with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *CompletedTask) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CompletedTask with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in CompletedTaskMultiError, or
+// nil if none found.
+func (m *CompletedTask) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CompletedTask) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ProjectId
+
+	// no validation rules for ProjectName
+
+	if all {
+		switch v := interface{}(m.GetTask()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CompletedTaskValidationError{
+					field:  "Task",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CompletedTaskValidationError{
+					field:  "Task",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTask()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CompletedTaskValidationError{
+				field:  "Task",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CompletedTaskMultiError(errors)
+	}
+
+	return nil
+}
+
+// CompletedTaskMultiError is an error wrapping multiple validation errors
+// returned by CompletedTask.ValidateAll() if the designated constraints aren't
+// met.
+type CompletedTaskMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CompletedTaskMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CompletedTaskMultiError) AllErrors() []error { return m }
+
+// CompletedTaskValidationError is the validation error returned by
+// CompletedTask.Validate if the designated constraints aren't met.
+type CompletedTaskValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CompletedTaskValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CompletedTaskValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CompletedTaskValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CompletedTaskValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CompletedTaskValidationError) ErrorName() string { return "CompletedTaskValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CompletedTaskValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCompletedTask.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CompletedTaskValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CompletedTaskValidationError{}
+
+// Validate checks the field values on ListCompletedTasksResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *ListCompletedTasksResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListCompletedTasksResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListCompletedTasksResponseMultiError, or nil if none found.
+func (m *ListCompletedTasksResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListCompletedTasksResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetTasks() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListCompletedTasksResponseValidationError{
+						field:  fmt.Sprintf("Tasks[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListCompletedTasksResponseValidationError{
+						field:  fmt.Sprintf("Tasks[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListCompletedTasksResponseValidationError{
+					field:  fmt.Sprintf("Tasks[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListCompletedTasksResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListCompletedTasksResponseMultiError is an error wrapping multiple
+// validation errors returned by ListCompletedTasksResponse.ValidateAll() if
+// the designated constraints aren't met.
+type ListCompletedTasksResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListCompletedTasksResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListCompletedTasksResponseMultiError) AllErrors() []error { return m }
+
+// ListCompletedTasksResponseValidationError is the validation error returned
+// by ListCompletedTasksResponse.Validate if the designated constraints aren't
+// met.
+type ListCompletedTasksResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListCompletedTasksResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListCompletedTasksResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListCompletedTasksResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListCompletedTasksResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListCompletedTasksResponseValidationError) ErrorName() string {
+	return "ListCompletedTasksResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListCompletedTasksResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListCompletedTasksResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListCompletedTasksResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListCompletedTasksResponseValidationError{}
+
+// Validate checks the field values on ArchiveProjectRequest with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the first error encountered is returned, or nil if there are no violations.
+func (m *ArchiveProjectRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ArchiveProjectRequest with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the result is a list of violation errors wrapped in
+// ArchiveProjectRequestMultiError, or nil if none found.
+func (m *ArchiveProjectRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ArchiveProjectRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetProjectId()) < 1 {
+		err := ArchiveProjectRequestValidationError{
+			field:  "ProjectId",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetUserId()) < 1 {
+		err := ArchiveProjectRequestValidationError{
+			field:  "UserId",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ArchiveProjectRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ArchiveProjectRequestMultiError is an error wrapping multiple validation
+// errors returned by ArchiveProjectRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ArchiveProjectRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ArchiveProjectRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ArchiveProjectRequestMultiError) AllErrors() []error { return m }
+
+// ArchiveProjectRequestValidationError is the validation error returned by
+// ArchiveProjectRequest.Validate if the designated constraints aren't met.
+type ArchiveProjectRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ArchiveProjectRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ArchiveProjectRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ArchiveProjectRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ArchiveProjectRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ArchiveProjectRequestValidationError) ErrorName() string {
+	return "ArchiveProjectRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ArchiveProjectRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sArchiveProjectRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ArchiveProjectRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ArchiveProjectRequestValidationError{}
+
+// Validate checks the field values on UserPreferences with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *UserPreferences) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UserPreferences with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the result is a list of violation errors wrapped in
+// UserPreferencesMultiError, or nil if none found.
+func (m *UserPreferences) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UserPreferences) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	if all {
+		switch v := interface{}(m.GetUpdatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UserPreferencesValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UserPreferencesValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UserPreferencesValidationError{
+				field:  "UpdatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UserPreferencesMultiError(errors)
+	}
+
+	return nil
+}
+
+// UserPreferencesMultiError is an error wrapping multiple validation errors
+// returned by UserPreferences.ValidateAll() if the designated constraints
+// aren't met.
+type UserPreferencesMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UserPreferencesMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UserPreferencesMultiError) AllErrors() []error { return m }
+
+// UserPreferencesValidationError is the validation error returned by
+// UserPreferences.Validate if the designated constraints aren't met.
+type UserPreferencesValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UserPreferencesValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UserPreferencesValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UserPreferencesValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UserPreferencesValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UserPreferencesValidationError) ErrorName() string {
+	return "UserPreferencesValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UserPreferencesValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUserPreferences.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UserPreferencesValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UserPreferencesValidationError{}
+
+// Validate checks the field values on GetUserPreferencesRequest with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the first error encountered is returned, or nil if there are no violations.
+func (m *GetUserPreferencesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetUserPreferencesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetUserPreferencesRequestMultiError, or nil if none found.
+func (m *GetUserPreferencesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetUserPreferencesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetUserId()) < 1 {
+		err := GetUserPreferencesRequestValidationError{
+			field:  "UserId",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetUserPreferencesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetUserPreferencesRequestMultiError is an error wrapping multiple validation
+// errors returned by GetUserPreferencesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetUserPreferencesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetUserPreferencesRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetUserPreferencesRequestMultiError) AllErrors() []error { return m }
+
+// GetUserPreferencesRequestValidationError is the validation error returned by
+// GetUserPreferencesRequest.Validate if the designated constraints aren't met.
+type GetUserPreferencesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetUserPreferencesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetUserPreferencesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetUserPreferencesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetUserPreferencesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetUserPreferencesRequestValidationError) ErrorName() string {
+	return "GetUserPreferencesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetUserPreferencesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetUserPreferencesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetUserPreferencesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetUserPreferencesRequestValidationError{}
+
+// Validate checks the field values on UpdateUserPreferencesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *UpdateUserPreferencesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateUserPreferencesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateUserPreferencesRequestMultiError, or nil if none found.
+func (m *UpdateUserPreferencesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateUserPreferencesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetUserId()) < 1 {
+		err := UpdateUserPreferencesRequestValidationError{
+			field:  "UserId",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetFieldMask()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateUserPreferencesRequestValidationError{
+					field:  "FieldMask",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateUserPreferencesRequestValidationError{
+					field:  "FieldMask",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFieldMask()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateUserPreferencesRequestValidationError{
+				field:  "FieldMask",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdateUserPreferencesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateUserPreferencesRequestMultiError is an error wrapping multiple
+// validation errors returned by UpdateUserPreferencesRequest.ValidateAll() if
+// the designated constraints aren't met.
+type UpdateUserPreferencesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateUserPreferencesRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateUserPreferencesRequestMultiError) AllErrors() []error { return m }
+
+// UpdateUserPreferencesRequestValidationError is the validation error returned
+// by UpdateUserPreferencesRequest.Validate if the designated constraints
+// aren't met.
+type UpdateUserPreferencesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateUserPreferencesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateUserPreferencesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateUserPreferencesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateUserPreferencesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateUserPreferencesRequestValidationError) ErrorName() string {
+	return "UpdateUserPreferencesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateUserPreferencesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateUserPreferencesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateUserPreferencesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateUserPreferencesRequestValidationError{}
+
+// Validate checks the field values on MoveProjectRequest with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the first error encountered is returned, or nil if there are no violations.
+func (m *MoveProjectRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MoveProjectRequest with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the result is a list of violation errors wrapped in
+// MoveProjectRequestMultiError, or nil if none found.
+func (m *MoveProjectRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MoveProjectRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetProjectId()) < 1 {
+		err := MoveProjectRequestValidationError{
+			field:  "ProjectId",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetUserId()) < 1 {
+		err := MoveProjectRequestValidationError{
+			field:  "UserId",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for ParentId
+
+	if len(errors) > 0 {
+		return MoveProjectRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// MoveProjectRequestMultiError is an error wrapping multiple validation errors
+// returned by MoveProjectRequest.ValidateAll() if the designated constraints
+// aren't met.
+type MoveProjectRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MoveProjectRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MoveProjectRequestMultiError) AllErrors() []error { return m }
+
+// MoveProjectRequestValidationError is the validation error returned by
+// MoveProjectRequest.Validate if the designated constraints aren't met.
+type MoveProjectRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MoveProjectRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MoveProjectRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MoveProjectRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MoveProjectRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MoveProjectRequestValidationError) ErrorName() string {
+	return "MoveProjectRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MoveProjectRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMoveProjectRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MoveProjectRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MoveProjectRequestValidationError{}
+
+// Validate checks the field values on GetProjectTreeRequest with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the first error encountered is returned, or nil if there are no violations.
+func (m *GetProjectTreeRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetProjectTreeRequest with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the result is a list of violation errors wrapped in
+// GetProjectTreeRequestMultiError, or nil if none found.
+func (m *GetProjectTreeRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetProjectTreeRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetUserId()) < 1 {
+		err := GetProjectTreeRequestValidationError{
+			field:  "UserId",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for IncludeArchived
+
+	if len(errors) > 0 {
+		return GetProjectTreeRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetProjectTreeRequestMultiError is an error wrapping multiple validation
+// errors returned by GetProjectTreeRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetProjectTreeRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetProjectTreeRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetProjectTreeRequestMultiError) AllErrors() []error { return m }
+
+// GetProjectTreeRequestValidationError is the validation error returned by
+// GetProjectTreeRequest.Validate if the designated constraints aren't met.
+type GetProjectTreeRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetProjectTreeRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetProjectTreeRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetProjectTreeRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetProjectTreeRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetProjectTreeRequestValidationError) ErrorName() string {
+	return "GetProjectTreeRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetProjectTreeRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetProjectTreeRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetProjectTreeRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetProjectTreeRequestValidationError{}
+
+// Validate checks the field values on ProjectTreeNode with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ProjectTreeNode) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ProjectTreeNode with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the result is a list of violation errors wrapped in
+// ProjectTreeNodeMultiError, or nil if none found.
+func (m *ProjectTreeNode) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ProjectTreeNode) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetProject()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ProjectTreeNodeValidationError{
+					field:  "Project",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ProjectTreeNodeValidationError{
+					field:  "Project",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetProject()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ProjectTreeNodeValidationError{
+				field:  "Project",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetChildren() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ProjectTreeNodeValidationError{
+						field:  fmt.Sprintf("Children[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ProjectTreeNodeValidationError{
+						field:  fmt.Sprintf("Children[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ProjectTreeNodeValidationError{
+					field:  fmt.Sprintf("Children[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ProjectTreeNodeMultiError(errors)
+	}
+
+	return nil
+}
+
+// ProjectTreeNodeMultiError is an error wrapping multiple validation errors
+// returned by ProjectTreeNode.ValidateAll() if the designated constraints
+// aren't met.
+type ProjectTreeNodeMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ProjectTreeNodeMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ProjectTreeNodeMultiError) AllErrors() []error { return m }
+
+// ProjectTreeNodeValidationError is the validation error returned by
+// ProjectTreeNode.Validate if the designated constraints aren't met.
+type ProjectTreeNodeValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ProjectTreeNodeValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ProjectTreeNodeValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ProjectTreeNodeValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ProjectTreeNodeValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ProjectTreeNodeValidationError) ErrorName() string {
+	return "ProjectTreeNodeValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ProjectTreeNodeValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sProjectTreeNode.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ProjectTreeNodeValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ProjectTreeNodeValidationError{}
+
+// Validate checks the field values on ProjectTree with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ProjectTree) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ProjectTree with the rules defined in
+// the proto definition for this message. If any rules are violated, the result
+// is a list of violation errors wrapped in ProjectTreeMultiError, or nil if
+// none found.
+func (m *ProjectTree) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ProjectTree) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetRoots() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ProjectTreeValidationError{
+						field:  fmt.Sprintf("Roots[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ProjectTreeValidationError{
+						field:  fmt.Sprintf("Roots[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ProjectTreeValidationError{
+					field:  fmt.Sprintf("Roots[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ProjectTreeMultiError(errors)
+	}
+
+	return nil
+}
+
+// ProjectTreeMultiError is an error wrapping multiple validation errors
+// returned by ProjectTree.ValidateAll() if the designated constraints aren't
+// met.
+type ProjectTreeMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ProjectTreeMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ProjectTreeMultiError) AllErrors() []error { return m }
+
+// ProjectTreeValidationError is the validation error returned by
+// ProjectTree.Validate if the designated constraints aren't met.
+type ProjectTreeValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ProjectTreeValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ProjectTreeValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ProjectTreeValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ProjectTreeValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ProjectTreeValidationError) ErrorName() string { return "ProjectTreeValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ProjectTreeValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sProjectTree.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ProjectTreeValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ProjectTreeValidationError{}
+
+// Validate checks the field values on SetMemberRoleRequest with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the first error encountered is returned, or nil if there are no violations.
+func (m *SetMemberRoleRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetMemberRoleRequest with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the result is a list of violation errors wrapped in
+// SetMemberRoleRequestMultiError, or nil if none found.
+func (m *SetMemberRoleRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetMemberRoleRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetProjectId()) < 1 {
+		err := SetMemberRoleRequestValidationError{
+			field:  "ProjectId",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetUserId()) < 1 {
+		err := SetMemberRoleRequestValidationError{
+			field:  "UserId",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetMemberId()) < 1 {
+		err := SetMemberRoleRequestValidationError{
+			field:  "MemberId",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := Role_name[int32(m.GetRole())]; !ok {
+		err := SetMemberRoleRequestValidationError{
+			field:  "Role",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return SetMemberRoleRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetMemberRoleRequestMultiError is an error wrapping multiple validation
+// errors returned by SetMemberRoleRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SetMemberRoleRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetMemberRoleRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetMemberRoleRequestMultiError) AllErrors() []error { return m }
+
+// SetMemberRoleRequestValidationError is the validation error returned by
+// SetMemberRoleRequest.Validate if the designated constraints aren't met.
+type SetMemberRoleRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetMemberRoleRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetMemberRoleRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetMemberRoleRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetMemberRoleRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetMemberRoleRequestValidationError) ErrorName() string {
+	return "SetMemberRoleRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetMemberRoleRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetMemberRoleRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetMemberRoleRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetMemberRoleRequestValidationError{}
+
+// Validate checks the field values on ServiceAccount with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ServiceAccount) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ServiceAccount with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ServiceAccountMultiError, or
+// nil if none found.
+func (m *ServiceAccount) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ServiceAccount) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for OwnerId
+
+	// no validation rules for Name
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ServiceAccountValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ServiceAccountValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ServiceAccountValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetApiKeys() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ServiceAccountValidationError{
+						field:  fmt.Sprintf("ApiKeys[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ServiceAccountValidationError{
+						field:  fmt.Sprintf("ApiKeys[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ServiceAccountValidationError{
+					field:  fmt.Sprintf("ApiKeys[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ServiceAccountMultiError(errors)
+	}
+
+	return nil
+}
+
+// ServiceAccountMultiError is an error wrapping multiple validation errors
+// returned by ServiceAccount.ValidateAll() if the designated constraints
+// aren't met.
+type ServiceAccountMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ServiceAccountMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ServiceAccountMultiError) AllErrors() []error { return m }
+
+// ServiceAccountValidationError is the validation error returned by
+// ServiceAccount.Validate if the designated constraints aren't met.
+type ServiceAccountValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ServiceAccountValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ServiceAccountValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ServiceAccountValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ServiceAccountValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ServiceAccountValidationError) ErrorName() string { return "ServiceAccountValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ServiceAccountValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sServiceAccount.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ServiceAccountValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ServiceAccountValidationError{}
+
+// Validate checks the field values on ApiKeyScope with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ApiKeyScope) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ApiKeyScope with the rules defined in
+// the proto definition for this message. If any rules are violated, the result
+// is a list of violation errors wrapped in ApiKeyScopeMultiError, or nil if
+// none found.
+func (m *ApiKeyScope) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ApiKeyScope) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return ApiKeyScopeMultiError(errors)
+	}
+
+	return nil
+}
+
+// ApiKeyScopeMultiError is an error wrapping multiple validation errors
+// returned by ApiKeyScope.ValidateAll() if the designated constraints aren't
+// met.
+type ApiKeyScopeMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ApiKeyScopeMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ApiKeyScopeMultiError) AllErrors() []error { return m }
+
+// ApiKeyScopeValidationError is the validation error returned by
+// ApiKeyScope.Validate if the designated constraints aren't met.
+type ApiKeyScopeValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ApiKeyScopeValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ApiKeyScopeValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ApiKeyScopeValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ApiKeyScopeValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ApiKeyScopeValidationError) ErrorName() string { return "ApiKeyScopeValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ApiKeyScopeValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sApiKeyScope.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ApiKeyScopeValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ApiKeyScopeValidationError{}
+
+// Validate checks the field values on ApiKey with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ApiKey) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ApiKey with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in ApiKeyMultiError, or nil if none
+// found.
+func (m *ApiKey) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ApiKey) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Name
+
+	if all {
+		switch v := interface{}(m.GetScope()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ApiKeyValidationError{
+					field:  "Scope",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ApiKeyValidationError{
+					field:  "Scope",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetScope()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ApiKeyValidationError{
+				field:  "Scope",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ApiKeyValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ApiKeyValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ApiKeyValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetLastUsedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ApiKeyValidationError{
+					field:  "LastUsedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ApiKeyValidationError{
+					field:  "LastUsedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLastUsedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ApiKeyValidationError{
+				field:  "LastUsedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetRevokedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ApiKeyValidationError{
+					field:  "RevokedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ApiKeyValidationError{
+					field:  "RevokedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRevokedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ApiKeyValidationError{
+				field:  "RevokedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ApiKeyMultiError(errors)
+	}
+
+	return nil
+}
+
+// ApiKeyMultiError is an error wrapping multiple validation errors returned by
+// ApiKey.ValidateAll() if the designated constraints aren't met.
+type ApiKeyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ApiKeyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ApiKeyMultiError) AllErrors() []error { return m }
+
+// ApiKeyValidationError is the validation error returned by ApiKey.Validate if
+// the designated constraints aren't met.
+type ApiKeyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ApiKeyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ApiKeyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ApiKeyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ApiKeyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ApiKeyValidationError) ErrorName() string { return "ApiKeyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ApiKeyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sApiKey.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ApiKeyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ApiKeyValidationError{}
+
+// Validate checks the field values on CreateServiceAccountRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *CreateServiceAccountRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateServiceAccountRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateServiceAccountRequestMultiError, or nil if none found.
+func (m *CreateServiceAccountRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateServiceAccountRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetUserId()) < 1 {
+		err := CreateServiceAccountRequestValidationError{
+			field:  "UserId",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetName()) < 1 {
+		err := CreateServiceAccountRequestValidationError{
+			field:  "Name",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return CreateServiceAccountRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateServiceAccountRequestMultiError is an error wrapping multiple
+// validation errors returned by CreateServiceAccountRequest.ValidateAll() if
+// the designated constraints aren't met.
+type CreateServiceAccountRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateServiceAccountRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateServiceAccountRequestMultiError) AllErrors() []error { return m }
+
+// CreateServiceAccountRequestValidationError is the validation error returned
+// by CreateServiceAccountRequest.Validate if the designated constraints aren't
+// met.
+type CreateServiceAccountRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateServiceAccountRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateServiceAccountRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateServiceAccountRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateServiceAccountRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateServiceAccountRequestValidationError) ErrorName() string {
+	return "CreateServiceAccountRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateServiceAccountRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateServiceAccountRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateServiceAccountRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateServiceAccountRequestValidationError{}
+
+// Validate checks the field values on ListServiceAccountsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *ListServiceAccountsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListServiceAccountsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListServiceAccountsRequestMultiError, or nil if none found.
+func (m *ListServiceAccountsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListServiceAccountsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetUserId()) < 1 {
+		err := ListServiceAccountsRequestValidationError{
+			field:  "UserId",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ListServiceAccountsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListServiceAccountsRequestMultiError is an error wrapping multiple
+// validation errors returned by ListServiceAccountsRequest.ValidateAll() if
+// the designated constraints aren't met.
+type ListServiceAccountsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListServiceAccountsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListServiceAccountsRequestMultiError) AllErrors() []error { return m }
+
+// ListServiceAccountsRequestValidationError is the validation error returned
+// by ListServiceAccountsRequest.Validate if the designated constraints aren't
+// met.
+type ListServiceAccountsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListServiceAccountsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListServiceAccountsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListServiceAccountsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListServiceAccountsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListServiceAccountsRequestValidationError) ErrorName() string {
+	return "ListServiceAccountsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListServiceAccountsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListServiceAccountsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListServiceAccountsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListServiceAccountsRequestValidationError{}
+
+// Validate checks the field values on ListServiceAccountsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *ListServiceAccountsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListServiceAccountsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListServiceAccountsResponseMultiError, or nil if none found.
+func (m *ListServiceAccountsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListServiceAccountsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetServiceAccounts() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListServiceAccountsResponseValidationError{
+						field:  fmt.Sprintf("ServiceAccounts[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListServiceAccountsResponseValidationError{
+						field:  fmt.Sprintf("ServiceAccounts[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListServiceAccountsResponseValidationError{
+					field:  fmt.Sprintf("ServiceAccounts[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListServiceAccountsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListServiceAccountsResponseMultiError is an error wrapping multiple
+// validation errors returned by ListServiceAccountsResponse.ValidateAll() if
+// the designated constraints aren't met.
+type ListServiceAccountsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListServiceAccountsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListServiceAccountsResponseMultiError) AllErrors() []error { return m }
+
+// ListServiceAccountsResponseValidationError is the validation error returned
+// by ListServiceAccountsResponse.Validate if the designated constraints aren't
+// met.
+type ListServiceAccountsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListServiceAccountsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListServiceAccountsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListServiceAccountsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListServiceAccountsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListServiceAccountsResponseValidationError) ErrorName() string {
+	return "ListServiceAccountsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListServiceAccountsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListServiceAccountsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListServiceAccountsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListServiceAccountsResponseValidationError{}
+
+// Validate checks the field values on CreateApiKeyRequest with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the first error encountered is returned, or nil if there are no violations.
+func (m *CreateApiKeyRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateApiKeyRequest with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the result is a list of violation errors wrapped in
+// CreateApiKeyRequestMultiError, or nil if none found.
+func (m *CreateApiKeyRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateApiKeyRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetUserId()) < 1 {
+		err := CreateApiKeyRequestValidationError{
+			field:  "UserId",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetServiceAccountId()) < 1 {
+		err := CreateApiKeyRequestValidationError{
+			field:  "ServiceAccountId",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetName()) < 1 {
+		err := CreateApiKeyRequestValidationError{
+			field:  "Name",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetScope()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateApiKeyRequestValidationError{
+					field:  "Scope",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateApiKeyRequestValidationError{
+					field:  "Scope",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetScope()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateApiKeyRequestValidationError{
+				field:  "Scope",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateApiKeyRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateApiKeyRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateApiKeyRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreateApiKeyRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateApiKeyRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateApiKeyRequestMultiError) AllErrors() []error { return m }
+
+// CreateApiKeyRequestValidationError is the validation error returned by
+// CreateApiKeyRequest.Validate if the designated constraints aren't met.
+type CreateApiKeyRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateApiKeyRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateApiKeyRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateApiKeyRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateApiKeyRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateApiKeyRequestValidationError) ErrorName() string {
+	return "CreateApiKeyRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateApiKeyRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateApiKeyRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateApiKeyRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateApiKeyRequestValidationError{}
+
+// Validate checks the field values on CreateApiKeyResponse with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the first error encountered is returned, or nil if there are no violations.
+func (m *CreateApiKeyResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateApiKeyResponse with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the result is a list of violation errors wrapped in
+// CreateApiKeyResponseMultiError, or nil if none found.
+func (m *CreateApiKeyResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateApiKeyResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetApiKey()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateApiKeyResponseValidationError{
+					field:  "ApiKey",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateApiKeyResponseValidationError{
+					field:  "ApiKey",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetApiKey()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateApiKeyResponseValidationError{
+				field:  "ApiKey",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Key
+
+	if len(errors) > 0 {
+		return CreateApiKeyResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateApiKeyResponseMultiError is an error wrapping multiple validation
+// errors returned by CreateApiKeyResponse.ValidateAll() if the designated
+// constraints aren't met.
+type CreateApiKeyResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateApiKeyResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateApiKeyResponseMultiError) AllErrors() []error { return m }
+
+// CreateApiKeyResponseValidationError is the validation error returned by
+// CreateApiKeyResponse.Validate if the designated constraints aren't met.
+type CreateApiKeyResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateApiKeyResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateApiKeyResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateApiKeyResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateApiKeyResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateApiKeyResponseValidationError) ErrorName() string {
+	return "CreateApiKeyResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateApiKeyResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateApiKeyResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateApiKeyResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateApiKeyResponseValidationError{}
+
+// Validate checks the field values on RevokeApiKeyRequest with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the first error encountered is returned, or nil if there are no violations.
+func (m *RevokeApiKeyRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RevokeApiKeyRequest with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the result is a list of violation errors wrapped in
+// RevokeApiKeyRequestMultiError, or nil if none found.
+func (m *RevokeApiKeyRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RevokeApiKeyRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetUserId()) < 1 {
+		err := RevokeApiKeyRequestValidationError{
+			field:  "UserId",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetServiceAccountId()) < 1 {
+		err := RevokeApiKeyRequestValidationError{
+			field:  "ServiceAccountId",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetApiKeyId()) < 1 {
+		err := RevokeApiKeyRequestValidationError{
+			field:  "ApiKeyId",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return RevokeApiKeyRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RevokeApiKeyRequestMultiError is an error wrapping multiple validation
+// errors returned by RevokeApiKeyRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RevokeApiKeyRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RevokeApiKeyRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RevokeApiKeyRequestMultiError) AllErrors() []error { return m }
+
+// RevokeApiKeyRequestValidationError is the validation error returned by
+// RevokeApiKeyRequest.Validate if the designated constraints aren't met.
+type RevokeApiKeyRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RevokeApiKeyRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RevokeApiKeyRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RevokeApiKeyRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RevokeApiKeyRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RevokeApiKeyRequestValidationError) ErrorName() string {
+	return "RevokeApiKeyRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RevokeApiKeyRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRevokeApiKeyRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RevokeApiKeyRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RevokeApiKeyRequestValidationError{}
+
+// Validate checks the field values on Invitation with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Invitation) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Invitation with the rules defined in
+// the proto definition for this message. If any rules are violated, the result
+// is a list of violation errors wrapped in InvitationMultiError, or nil if
+// none found.
+func (m *Invitation) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Invitation) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for ProjectId
+
+	// no validation rules for ProjectName
+
+	// no validation rules for InviterId
+
+	// no validation rules for InviteeId
+
+	// no validation rules for Role
+
+	// no validation rules for Status
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, InvitationValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, InvitationValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return InvitationValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetExpiresAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, InvitationValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, InvitationValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetExpiresAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return InvitationValidationError{
+				field:  "ExpiresAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetRespondedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, InvitationValidationError{
+					field:  "RespondedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, InvitationValidationError{
+					field:  "RespondedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRespondedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return InvitationValidationError{
+				field:  "RespondedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return InvitationMultiError(errors)
+	}
+
+	return nil
+}
+
+// InvitationMultiError is an error wrapping multiple validation errors
+// returned by Invitation.ValidateAll() if the designated constraints aren't
+// met.
+type InvitationMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InvitationMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InvitationMultiError) AllErrors() []error { return m }
+
+// InvitationValidationError is the validation error returned by
+// Invitation.Validate if the designated constraints aren't met.
+type InvitationValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InvitationValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InvitationValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InvitationValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InvitationValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InvitationValidationError) ErrorName() string { return "InvitationValidationError" }
+
+// Error satisfies the builtin error interface
+func (e InvitationValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInvitation.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InvitationValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InvitationValidationError{}
+
+// Validate checks the field values on InviteToProjectRequest with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the first error encountered is returned, or nil if there are no violations.
+func (m *InviteToProjectRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InviteToProjectRequest with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the result is a list of violation errors wrapped in
+// InviteToProjectRequestMultiError, or nil if none found.
+func (m *InviteToProjectRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InviteToProjectRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetProjectId()) < 1 {
+		err := InviteToProjectRequestValidationError{
+			field:  "ProjectId",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetUserId()) < 1 {
+		err := InviteToProjectRequestValidationError{
+			field:  "UserId",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetInviteeId()) < 1 {
+		err := InviteToProjectRequestValidationError{
+			field:  "InviteeId",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := Role_name[int32(m.GetRole())]; !ok {
+		err := InviteToProjectRequestValidationError{
+			field:  "Role",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return InviteToProjectRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// InviteToProjectRequestMultiError is an error wrapping multiple validation
+// errors returned by InviteToProjectRequest.ValidateAll() if the designated
+// constraints aren't met.
+type InviteToProjectRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InviteToProjectRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InviteToProjectRequestMultiError) AllErrors() []error { return m }
+
+// InviteToProjectRequestValidationError is the validation error returned by
+// InviteToProjectRequest.Validate if the designated constraints aren't met.
+type InviteToProjectRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InviteToProjectRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InviteToProjectRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InviteToProjectRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InviteToProjectRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InviteToProjectRequestValidationError) ErrorName() string {
+	return "InviteToProjectRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e InviteToProjectRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInviteToProjectRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InviteToProjectRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InviteToProjectRequestValidationError{}
+
+// Validate checks the field values on ListInvitationsRequest with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the first error encountered is returned, or nil if there are no violations.
+func (m *ListInvitationsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListInvitationsRequest with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the result is a list of violation errors wrapped in
+// ListInvitationsRequestMultiError, or nil if none found.
+func (m *ListInvitationsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListInvitationsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetUserId()) < 1 {
+		err := ListInvitationsRequestValidationError{
+			field:  "UserId",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ListInvitationsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListInvitationsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListInvitationsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListInvitationsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListInvitationsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListInvitationsRequestMultiError) AllErrors() []error { return m }
+
+// ListInvitationsRequestValidationError is the validation error returned by
+// ListInvitationsRequest.Validate if the designated constraints aren't met.
+type ListInvitationsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListInvitationsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListInvitationsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListInvitationsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListInvitationsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListInvitationsRequestValidationError) ErrorName() string {
+	return "ListInvitationsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListInvitationsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListInvitationsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListInvitationsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListInvitationsRequestValidationError{}
+
+// Validate checks the field values on ListInvitationsResponse with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the first error encountered is returned, or nil if there are no violations.
+func (m *ListInvitationsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListInvitationsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListInvitationsResponseMultiError, or nil if none found.
+func (m *ListInvitationsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListInvitationsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetInvitations() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListInvitationsResponseValidationError{
+						field:  fmt.Sprintf("Invitations[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListInvitationsResponseValidationError{
+						field:  fmt.Sprintf("Invitations[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListInvitationsResponseValidationError{
+					field:  fmt.Sprintf("Invitations[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListInvitationsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListInvitationsResponseMultiError is an error wrapping multiple validation
+// errors returned by ListInvitationsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListInvitationsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListInvitationsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListInvitationsResponseMultiError) AllErrors() []error { return m }
+
+// ListInvitationsResponseValidationError is the validation error returned by
+// ListInvitationsResponse.Validate if the designated constraints aren't met.
+type ListInvitationsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListInvitationsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListInvitationsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListInvitationsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListInvitationsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListInvitationsResponseValidationError) ErrorName() string {
+	return "ListInvitationsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListInvitationsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListInvitationsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListInvitationsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListInvitationsResponseValidationError{}
+
+// Validate checks the field values on InvitationResponseRequest with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the first error encountered is returned, or nil if there are no violations.
+func (m *InvitationResponseRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InvitationResponseRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// InvitationResponseRequestMultiError, or nil if none found.
+func (m *InvitationResponseRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InvitationResponseRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetInvitationId()) < 1 {
+		err := InvitationResponseRequestValidationError{
+			field:  "InvitationId",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetUserId()) < 1 {
+		err := InvitationResponseRequestValidationError{
+			field:  "UserId",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return InvitationResponseRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// InvitationResponseRequestMultiError is an error wrapping multiple validation
+// errors returned by InvitationResponseRequest.ValidateAll() if the designated
+// constraints aren't met.
+type InvitationResponseRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InvitationResponseRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InvitationResponseRequestMultiError) AllErrors() []error { return m }
+
+// InvitationResponseRequestValidationError is the validation error returned by
+// InvitationResponseRequest.Validate if the designated constraints aren't met.
+type InvitationResponseRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InvitationResponseRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InvitationResponseRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InvitationResponseRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InvitationResponseRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InvitationResponseRequestValidationError) ErrorName() string {
+	return "InvitationResponseRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e InvitationResponseRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInvitationResponseRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InvitationResponseRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InvitationResponseRequestValidationError{}
+
+// Validate checks the field values on LeaveProjectRequest with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the first error encountered is returned, or nil if there are no violations.
+func (m *LeaveProjectRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on LeaveProjectRequest with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the result is a list of violation errors wrapped in
+// LeaveProjectRequestMultiError, or nil if none found.
+func (m *LeaveProjectRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *LeaveProjectRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetProjectId()) < 1 {
+		err := LeaveProjectRequestValidationError{
+			field:  "ProjectId",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetUserId()) < 1 {
+		err := LeaveProjectRequestValidationError{
+			field:  "UserId",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return LeaveProjectRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// LeaveProjectRequestMultiError is an error wrapping multiple validation
+// errors returned by LeaveProjectRequest.ValidateAll() if the designated
+// constraints aren't met.
+type LeaveProjectRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m LeaveProjectRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m LeaveProjectRequestMultiError) AllErrors() []error { return m }
+
+// LeaveProjectRequestValidationError is the validation error returned by
+// LeaveProjectRequest.Validate if the designated constraints aren't met.
+type LeaveProjectRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e LeaveProjectRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e LeaveProjectRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e LeaveProjectRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e LeaveProjectRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e LeaveProjectRequestValidationError) ErrorName() string {
+	return "LeaveProjectRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e LeaveProjectRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sLeaveProjectRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = LeaveProjectRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = LeaveProjectRequestValidationError{}
+
+// Validate checks the field values on TransferOwnershipRequest with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the first error encountered is returned, or nil if there are no violations.
+func (m *TransferOwnershipRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TransferOwnershipRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TransferOwnershipRequestMultiError, or nil if none found.
+func (m *TransferOwnershipRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TransferOwnershipRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetProjectId()) < 1 {
+		err := TransferOwnershipRequestValidationError{
+			field:  "ProjectId",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetUserId()) < 1 {
+		err := TransferOwnershipRequestValidationError{
+			field:  "UserId",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetNewOwnerId()) < 1 {
+		err := TransferOwnershipRequestValidationError{
+			field:  "NewOwnerId",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return TransferOwnershipRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// TransferOwnershipRequestMultiError is an error wrapping multiple validation
+// errors returned by TransferOwnershipRequest.ValidateAll() if the designated
+// constraints aren't met.
+type TransferOwnershipRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TransferOwnershipRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TransferOwnershipRequestMultiError) AllErrors() []error { return m }
+
+// TransferOwnershipRequestValidationError is the validation error returned by
+// TransferOwnershipRequest.Validate if the designated constraints aren't met.
+type TransferOwnershipRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TransferOwnershipRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TransferOwnershipRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TransferOwnershipRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TransferOwnershipRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TransferOwnershipRequestValidationError) ErrorName() string {
+	return "TransferOwnershipRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e TransferOwnershipRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTransferOwnershipRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TransferOwnershipRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TransferOwnershipRequestValidationError{}
+
+// Validate checks the field values on OwnershipTransferRequest with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the first error encountered is returned, or nil if there are no violations.
+func (m *OwnershipTransferRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on OwnershipTransferRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// OwnershipTransferRequestMultiError, or nil if none found.
+func (m *OwnershipTransferRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *OwnershipTransferRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetProjectId()) < 1 {
+		err := OwnershipTransferRequestValidationError{
+			field:  "ProjectId",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetUserId()) < 1 {
+		err := OwnershipTransferRequestValidationError{
+			field:  "UserId",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return OwnershipTransferRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// OwnershipTransferRequestMultiError is an error wrapping multiple validation
+// errors returned by OwnershipTransferRequest.ValidateAll() if the designated
+// constraints aren't met.
+type OwnershipTransferRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m OwnershipTransferRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m OwnershipTransferRequestMultiError) AllErrors() []error { return m }
+
+// OwnershipTransferRequestValidationError is the validation error returned by
+// OwnershipTransferRequest.Validate if the designated constraints aren't met.
+type OwnershipTransferRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e OwnershipTransferRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e OwnershipTransferRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e OwnershipTransferRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e OwnershipTransferRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e OwnershipTransferRequestValidationError) ErrorName() string {
+	return "OwnershipTransferRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e OwnershipTransferRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sOwnershipTransferRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = OwnershipTransferRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = OwnershipTransferRequestValidationError{}
+
+// Validate checks the field values on ShareLink with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ShareLink) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ShareLink with the rules defined in
+// the proto definition for this message. If any rules are violated, the result
+// is a list of violation errors wrapped in ShareLinkMultiError, or nil if none
+// found.
+func (m *ShareLink) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ShareLink) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for ProjectId
+
+	// no validation rules for CreatedBy
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ShareLinkValidationError{
+					field:  "CreatedAt",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, ProjectValidationError{
+				errors = append(errors, ShareLinkValidationError{
 					field:  "CreatedAt",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -297,7 +14365,7 @@ func (m *Project) validate(all bool) error {
 		}
 	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return ProjectValidationError{
+			return ShareLinkValidationError{
 				field:  "CreatedAt",
 				reason: "embedded message failed validation",
 				cause:  err,
@@ -306,49 +14374,391 @@ func (m *Project) validate(all bool) error {
 	}
 
 	if all {
-		switch v := interface{}(m.GetUpdatedAt()).(type) {
+		switch v := interface{}(m.GetExpiresAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ShareLinkValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ShareLinkValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetExpiresAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ShareLinkValidationError{
+				field:  "ExpiresAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetRevokedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ShareLinkValidationError{
+					field:  "RevokedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ShareLinkValidationError{
+					field:  "RevokedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRevokedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ShareLinkValidationError{
+				field:  "RevokedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetLastAccessedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ShareLinkValidationError{
+					field:  "LastAccessedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ShareLinkValidationError{
+					field:  "LastAccessedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLastAccessedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ShareLinkValidationError{
+				field:  "LastAccessedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for AccessCount
+
+	if len(errors) > 0 {
+		return ShareLinkMultiError(errors)
+	}
+
+	return nil
+}
+
+// ShareLinkMultiError is an error wrapping multiple validation errors returned
+// by ShareLink.ValidateAll() if the designated constraints aren't met.
+type ShareLinkMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ShareLinkMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ShareLinkMultiError) AllErrors() []error { return m }
+
+// ShareLinkValidationError is the validation error returned by
+// ShareLink.Validate if the designated constraints aren't met.
+type ShareLinkValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ShareLinkValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ShareLinkValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ShareLinkValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ShareLinkValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ShareLinkValidationError) ErrorName() string { return "ShareLinkValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ShareLinkValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sShareLink.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ShareLinkValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ShareLinkValidationError{}
+
+// Validate checks the field values on CreateShareLinkRequest with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the first error encountered is returned, or nil if there are no violations.
+func (m *CreateShareLinkRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateShareLinkRequest with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the result is a list of violation errors wrapped in
+// CreateShareLinkRequestMultiError, or nil if none found.
+func (m *CreateShareLinkRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateShareLinkRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetProjectId()) < 1 {
+		err := CreateShareLinkRequestValidationError{
+			field:  "ProjectId",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetUserId()) < 1 {
+		err := CreateShareLinkRequestValidationError{
+			field:  "UserId",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetTtl()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateShareLinkRequestValidationError{
+					field:  "Ttl",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateShareLinkRequestValidationError{
+					field:  "Ttl",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTtl()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateShareLinkRequestValidationError{
+				field:  "Ttl",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateShareLinkRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateShareLinkRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateShareLinkRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreateShareLinkRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateShareLinkRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateShareLinkRequestMultiError) AllErrors() []error { return m }
+
+// CreateShareLinkRequestValidationError is the validation error returned by
+// CreateShareLinkRequest.Validate if the designated constraints aren't met.
+type CreateShareLinkRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateShareLinkRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateShareLinkRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateShareLinkRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateShareLinkRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateShareLinkRequestValidationError) ErrorName() string {
+	return "CreateShareLinkRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateShareLinkRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateShareLinkRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateShareLinkRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateShareLinkRequestValidationError{}
+
+// Validate checks the field values on CreateShareLinkResponse with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the first error encountered is returned, or nil if there are no violations.
+func (m *CreateShareLinkResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateShareLinkResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateShareLinkResponseMultiError, or nil if none found.
+func (m *CreateShareLinkResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateShareLinkResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetShareLink()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, ProjectValidationError{
-					field:  "UpdatedAt",
+				errors = append(errors, CreateShareLinkResponseValidationError{
+					field:  "ShareLink",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, ProjectValidationError{
-					field:  "UpdatedAt",
+				errors = append(errors, CreateShareLinkResponseValidationError{
+					field:  "ShareLink",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetShareLink()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return ProjectValidationError{
-				field:  "UpdatedAt",
+			return CreateShareLinkResponseValidationError{
+				field:  "ShareLink",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
 		}
 	}
 
-	// no validation rules for Version
+	// no validation rules for Token
 
 	if len(errors) > 0 {
-		return ProjectMultiError(errors)
+		return CreateShareLinkResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// ProjectMultiError is an error wrapping multiple validation errors returned
-// by Project.ValidateAll() if the designated constraints aren't met.
-type ProjectMultiError []error
+// CreateShareLinkResponseMultiError is an error wrapping multiple validation
+// errors returned by CreateShareLinkResponse.ValidateAll() if the designated
+// constraints aren't met.
+type CreateShareLinkResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ProjectMultiError) Error() string {
+func (m CreateShareLinkResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -357,11 +14767,11 @@ func (m ProjectMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ProjectMultiError) AllErrors() []error { return m }
+func (m CreateShareLinkResponseMultiError) AllErrors() []error { return m }
 
-// ProjectValidationError is the validation error returned by Project.Validate
-// if the designated constraints aren't met.
-type ProjectValidationError struct {
+// CreateShareLinkResponseValidationError is the validation error returned by
+// CreateShareLinkResponse.Validate if the designated constraints aren't met.
+type CreateShareLinkResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -369,22 +14779,24 @@ type ProjectValidationError struct {
 }
 
 // Field function returns field value.
-func (e ProjectValidationError) Field() string { return e.field }
+func (e CreateShareLinkResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ProjectValidationError) Reason() string { return e.reason }
+func (e CreateShareLinkResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ProjectValidationError) Cause() error { return e.cause }
+func (e CreateShareLinkResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ProjectValidationError) Key() bool { return e.key }
+func (e CreateShareLinkResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ProjectValidationError) ErrorName() string { return "ProjectValidationError" }
+func (e CreateShareLinkResponseValidationError) ErrorName() string {
+	return "CreateShareLinkResponseValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e ProjectValidationError) Error() string {
+func (e CreateShareLinkResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -396,14 +14808,14 @@ func (e ProjectValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sProject.%s: %s%s",
+		"invalid %sCreateShareLinkResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ProjectValidationError{}
+var _ error = CreateShareLinkResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -411,104 +14823,202 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ProjectValidationError{}
+} = CreateShareLinkResponseValidationError{}
 
-// Validate checks the field values on Event with the rules defined in the
-// proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *Event) Validate() error {
+// Validate checks the field values on ListShareLinksRequest with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the first error encountered is returned, or nil if there are no violations.
+func (m *ListShareLinksRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on Event with the rules defined in the
-// proto definition for this message. If any rules are violated, the result is
-// a list of violation errors wrapped in EventMultiError, or nil if none found.
-func (m *Event) ValidateAll() error {
+// ValidateAll checks the field values on ListShareLinksRequest with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the result is a list of violation errors wrapped in
+// ListShareLinksRequestMultiError, or nil if none found.
+func (m *ListShareLinksRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *Event) validate(all bool) error {
+func (m *ListShareLinksRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
-
-	// no validation rules for Type
+	if len(m.GetProjectId()) < 1 {
+		err := ListShareLinksRequestValidationError{
+			field:  "ProjectId",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-	if all {
-		switch v := interface{}(m.GetProject()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, EventValidationError{
-					field:  "Project",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, EventValidationError{
-					field:  "Project",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
+	if len(m.GetUserId()) < 1 {
+		err := ListShareLinksRequestValidationError{
+			field:  "UserId",
+			reason: "value length must be at least 1 bytes",
 		}
-	} else if v, ok := interface{}(m.GetProject()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return EventValidationError{
-				field:  "Project",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
+		if !all {
+			return err
 		}
+		errors = append(errors, err)
 	}
 
-	if all {
-		switch v := interface{}(m.GetCreatedAt()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, EventValidationError{
-					field:  "CreatedAt",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
+	if len(errors) > 0 {
+		return ListShareLinksRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListShareLinksRequestMultiError is an error wrapping multiple validation
+// errors returned by ListShareLinksRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListShareLinksRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListShareLinksRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListShareLinksRequestMultiError) AllErrors() []error { return m }
+
+// ListShareLinksRequestValidationError is the validation error returned by
+// ListShareLinksRequest.Validate if the designated constraints aren't met.
+type ListShareLinksRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListShareLinksRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListShareLinksRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListShareLinksRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListShareLinksRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListShareLinksRequestValidationError) ErrorName() string {
+	return "ListShareLinksRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListShareLinksRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListShareLinksRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListShareLinksRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListShareLinksRequestValidationError{}
+
+// Validate checks the field values on ListShareLinksResponse with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the first error encountered is returned, or nil if there are no violations.
+func (m *ListShareLinksResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListShareLinksResponse with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the result is a list of violation errors wrapped in
+// ListShareLinksResponseMultiError, or nil if none found.
+func (m *ListShareLinksResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListShareLinksResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetShareLinks() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListShareLinksResponseValidationError{
+						field:  fmt.Sprintf("ShareLinks[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListShareLinksResponseValidationError{
+						field:  fmt.Sprintf("ShareLinks[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
 			}
-		case interface{ Validate() error }:
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				errors = append(errors, EventValidationError{
-					field:  "CreatedAt",
+				return ListShareLinksResponseValidationError{
+					field:  fmt.Sprintf("ShareLinks[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return EventValidationError{
-				field:  "CreatedAt",
-				reason: "embedded message failed validation",
-				cause:  err,
+				}
 			}
 		}
+
 	}
 
 	if len(errors) > 0 {
-		return EventMultiError(errors)
+		return ListShareLinksResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// EventMultiError is an error wrapping multiple validation errors returned by
-// Event.ValidateAll() if the designated constraints aren't met.
-type EventMultiError []error
+// ListShareLinksResponseMultiError is an error wrapping multiple validation
+// errors returned by ListShareLinksResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListShareLinksResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m EventMultiError) Error() string {
+func (m ListShareLinksResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -517,11 +15027,11 @@ func (m EventMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m EventMultiError) AllErrors() []error { return m }
+func (m ListShareLinksResponseMultiError) AllErrors() []error { return m }
 
-// EventValidationError is the validation error returned by Event.Validate if
-// the designated constraints aren't met.
-type EventValidationError struct {
+// ListShareLinksResponseValidationError is the validation error returned by
+// ListShareLinksResponse.Validate if the designated constraints aren't met.
+type ListShareLinksResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -529,22 +15039,24 @@ type EventValidationError struct {
 }
 
 // Field function returns field value.
-func (e EventValidationError) Field() string { return e.field }
+func (e ListShareLinksResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e EventValidationError) Reason() string { return e.reason }
+func (e ListShareLinksResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e EventValidationError) Cause() error { return e.cause }
+func (e ListShareLinksResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e EventValidationError) Key() bool { return e.key }
+func (e ListShareLinksResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e EventValidationError) ErrorName() string { return "EventValidationError" }
+func (e ListShareLinksResponseValidationError) ErrorName() string {
+	return "ListShareLinksResponseValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e EventValidationError) Error() string {
+func (e ListShareLinksResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -556,14 +15068,14 @@ func (e EventValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sEvent.%s: %s%s",
+		"invalid %sListShareLinksResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = EventValidationError{}
+var _ error = ListShareLinksResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -571,33 +15083,33 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = EventValidationError{}
+} = ListShareLinksResponseValidationError{}
 
-// Validate checks the field values on CreateProjectRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CreateProjectRequest) Validate() error {
+// Validate checks the field values on RevokeShareLinkRequest with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the first error encountered is returned, or nil if there are no violations.
+func (m *RevokeShareLinkRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateProjectRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// CreateProjectRequestMultiError, or nil if none found.
-func (m *CreateProjectRequest) ValidateAll() error {
+// ValidateAll checks the field values on RevokeShareLinkRequest with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the result is a list of violation errors wrapped in
+// RevokeShareLinkRequestMultiError, or nil if none found.
+func (m *RevokeShareLinkRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateProjectRequest) validate(all bool) error {
+func (m *RevokeShareLinkRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if len(m.GetName()) < 1 {
-		err := CreateProjectRequestValidationError{
-			field:  "Name",
+	if len(m.GetProjectId()) < 1 {
+		err := RevokeShareLinkRequestValidationError{
+			field:  "ProjectId",
 			reason: "value length must be at least 1 bytes",
 		}
 		if !all {
@@ -606,9 +15118,20 @@ func (m *CreateProjectRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if len(m.GetOwnerId()) < 1 {
-		err := CreateProjectRequestValidationError{
-			field:  "OwnerId",
+	if len(m.GetUserId()) < 1 {
+		err := RevokeShareLinkRequestValidationError{
+			field:  "UserId",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetShareLinkId()) < 1 {
+		err := RevokeShareLinkRequestValidationError{
+			field:  "ShareLinkId",
 			reason: "value length must be at least 1 bytes",
 		}
 		if !all {
@@ -618,19 +15141,19 @@ func (m *CreateProjectRequest) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return CreateProjectRequestMultiError(errors)
+		return RevokeShareLinkRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateProjectRequestMultiError is an error wrapping multiple validation
-// errors returned by CreateProjectRequest.ValidateAll() if the designated
+// RevokeShareLinkRequestMultiError is an error wrapping multiple validation
+// errors returned by RevokeShareLinkRequest.ValidateAll() if the designated
 // constraints aren't met.
-type CreateProjectRequestMultiError []error
+type RevokeShareLinkRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateProjectRequestMultiError) Error() string {
+func (m RevokeShareLinkRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -639,11 +15162,11 @@ func (m CreateProjectRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateProjectRequestMultiError) AllErrors() []error { return m }
+func (m RevokeShareLinkRequestMultiError) AllErrors() []error { return m }
 
-// CreateProjectRequestValidationError is the validation error returned by
-// CreateProjectRequest.Validate if the designated constraints aren't met.
-type CreateProjectRequestValidationError struct {
+// RevokeShareLinkRequestValidationError is the validation error returned by
+// RevokeShareLinkRequest.Validate if the designated constraints aren't met.
+type RevokeShareLinkRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -651,24 +15174,24 @@ type CreateProjectRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateProjectRequestValidationError) Field() string { return e.field }
+func (e RevokeShareLinkRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateProjectRequestValidationError) Reason() string { return e.reason }
+func (e RevokeShareLinkRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateProjectRequestValidationError) Cause() error { return e.cause }
+func (e RevokeShareLinkRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateProjectRequestValidationError) Key() bool { return e.key }
+func (e RevokeShareLinkRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateProjectRequestValidationError) ErrorName() string {
-	return "CreateProjectRequestValidationError"
+func (e RevokeShareLinkRequestValidationError) ErrorName() string {
+	return "RevokeShareLinkRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CreateProjectRequestValidationError) Error() string {
+func (e RevokeShareLinkRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -680,14 +15203,14 @@ func (e CreateProjectRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateProjectRequest.%s: %s%s",
+		"invalid %sRevokeShareLinkRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateProjectRequestValidationError{}
+var _ error = RevokeShareLinkRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -695,44 +15218,33 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateProjectRequestValidationError{}
+} = RevokeShareLinkRequestValidationError{}
 
-// Validate checks the field values on UpdateProjectRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UpdateProjectRequest) Validate() error {
+// Validate checks the field values on GetSharedProjectRequest with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the first error encountered is returned, or nil if there are no violations.
+func (m *GetSharedProjectRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UpdateProjectRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on GetSharedProjectRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// UpdateProjectRequestMultiError, or nil if none found.
-func (m *UpdateProjectRequest) ValidateAll() error {
+// GetSharedProjectRequestMultiError, or nil if none found.
+func (m *GetSharedProjectRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UpdateProjectRequest) validate(all bool) error {
+func (m *GetSharedProjectRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if len(m.GetProjectId()) < 1 {
-		err := UpdateProjectRequestValidationError{
-			field:  "ProjectId",
-			reason: "value length must be at least 1 bytes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if len(m.GetUserId()) < 1 {
-		err := UpdateProjectRequestValidationError{
-			field:  "UserId",
+	if len(m.GetToken()) < 1 {
+		err := GetSharedProjectRequestValidationError{
+			field:  "Token",
 			reason: "value length must be at least 1 bytes",
 		}
 		if !all {
@@ -741,53 +15253,20 @@ func (m *UpdateProjectRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	// no validation rules for Name
-
-	// no validation rules for OwnerId
-
-	if all {
-		switch v := interface{}(m.GetFieldMask()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, UpdateProjectRequestValidationError{
-					field:  "FieldMask",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, UpdateProjectRequestValidationError{
-					field:  "FieldMask",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetFieldMask()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return UpdateProjectRequestValidationError{
-				field:  "FieldMask",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
 	if len(errors) > 0 {
-		return UpdateProjectRequestMultiError(errors)
+		return GetSharedProjectRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// UpdateProjectRequestMultiError is an error wrapping multiple validation
-// errors returned by UpdateProjectRequest.ValidateAll() if the designated
+// GetSharedProjectRequestMultiError is an error wrapping multiple validation
+// errors returned by GetSharedProjectRequest.ValidateAll() if the designated
 // constraints aren't met.
-type UpdateProjectRequestMultiError []error
+type GetSharedProjectRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UpdateProjectRequestMultiError) Error() string {
+func (m GetSharedProjectRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -796,11 +15275,11 @@ func (m UpdateProjectRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UpdateProjectRequestMultiError) AllErrors() []error { return m }
+func (m GetSharedProjectRequestMultiError) AllErrors() []error { return m }
 
-// UpdateProjectRequestValidationError is the validation error returned by
-// UpdateProjectRequest.Validate if the designated constraints aren't met.
-type UpdateProjectRequestValidationError struct {
+// GetSharedProjectRequestValidationError is the validation error returned by
+// GetSharedProjectRequest.Validate if the designated constraints aren't met.
+type GetSharedProjectRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -808,24 +15287,24 @@ type UpdateProjectRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpdateProjectRequestValidationError) Field() string { return e.field }
+func (e GetSharedProjectRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpdateProjectRequestValidationError) Reason() string { return e.reason }
+func (e GetSharedProjectRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpdateProjectRequestValidationError) Cause() error { return e.cause }
+func (e GetSharedProjectRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpdateProjectRequestValidationError) Key() bool { return e.key }
+func (e GetSharedProjectRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpdateProjectRequestValidationError) ErrorName() string {
-	return "UpdateProjectRequestValidationError"
+func (e GetSharedProjectRequestValidationError) ErrorName() string {
+	return "GetSharedProjectRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UpdateProjectRequestValidationError) Error() string {
+func (e GetSharedProjectRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -837,14 +15316,14 @@ func (e UpdateProjectRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdateProjectRequest.%s: %s%s",
+		"invalid %sGetSharedProjectRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpdateProjectRequestValidationError{}
+var _ error = GetSharedProjectRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -852,55 +15331,217 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpdateProjectRequestValidationError{}
+} = GetSharedProjectRequestValidationError{}
 
-// Validate checks the field values on AllProjectsRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AllProjectsRequest) Validate() error {
+// Validate checks the field values on SharedProject with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SharedProject) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AllProjectsRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// AllProjectsRequestMultiError, or nil if none found.
-func (m *AllProjectsRequest) ValidateAll() error {
+// ValidateAll checks the field values on SharedProject with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SharedProjectMultiError, or
+// nil if none found.
+func (m *SharedProject) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AllProjectsRequest) validate(all bool) error {
+func (m *SharedProject) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if len(m.GetUserId()) < 1 {
-		err := AllProjectsRequestValidationError{
-			field:  "UserId",
-			reason: "value length must be at least 1 bytes",
+	// no validation rules for Name
+
+	// no validation rules for Description
+
+	// no validation rules for Color
+
+	// no validation rules for Icon
+
+	for idx, item := range m.GetSections() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SharedProjectValidationError{
+						field:  fmt.Sprintf("Sections[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SharedProjectValidationError{
+						field:  fmt.Sprintf("Sections[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SharedProjectValidationError{
+					field:  fmt.Sprintf("Sections[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
 		}
-		if !all {
-			return err
+
+	}
+
+	for idx, item := range m.GetStatuses() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SharedProjectValidationError{
+						field:  fmt.Sprintf("Statuses[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SharedProjectValidationError{
+						field:  fmt.Sprintf("Statuses[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SharedProjectValidationError{
+					field:  fmt.Sprintf("Statuses[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetCustomFields() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SharedProjectValidationError{
+						field:  fmt.Sprintf("CustomFields[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SharedProjectValidationError{
+						field:  fmt.Sprintf("CustomFields[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SharedProjectValidationError{
+					field:  fmt.Sprintf("CustomFields[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetTasks() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SharedProjectValidationError{
+						field:  fmt.Sprintf("Tasks[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SharedProjectValidationError{
+						field:  fmt.Sprintf("Tasks[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SharedProjectValidationError{
+					field:  fmt.Sprintf("Tasks[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SharedProjectValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SharedProjectValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SharedProjectValidationError{
+				field:  "UpdatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
 		}
-		errors = append(errors, err)
 	}
 
 	if len(errors) > 0 {
-		return AllProjectsRequestMultiError(errors)
+		return SharedProjectMultiError(errors)
 	}
 
 	return nil
 }
 
-// AllProjectsRequestMultiError is an error wrapping multiple validation errors
-// returned by AllProjectsRequest.ValidateAll() if the designated constraints
-// aren't met.
-type AllProjectsRequestMultiError []error
+// SharedProjectMultiError is an error wrapping multiple validation errors
+// returned by SharedProject.ValidateAll() if the designated constraints aren't
+// met.
+type SharedProjectMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AllProjectsRequestMultiError) Error() string {
+func (m SharedProjectMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -909,11 +15550,11 @@ func (m AllProjectsRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AllProjectsRequestMultiError) AllErrors() []error { return m }
+func (m SharedProjectMultiError) AllErrors() []error { return m }
 
-// AllProjectsRequestValidationError is the validation error returned by
-// AllProjectsRequest.Validate if the designated constraints aren't met.
-type AllProjectsRequestValidationError struct {
+// SharedProjectValidationError is the validation error returned by
+// SharedProject.Validate if the designated constraints aren't met.
+type SharedProjectValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -921,24 +15562,22 @@ type AllProjectsRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e AllProjectsRequestValidationError) Field() string { return e.field }
+func (e SharedProjectValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AllProjectsRequestValidationError) Reason() string { return e.reason }
+func (e SharedProjectValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AllProjectsRequestValidationError) Cause() error { return e.cause }
+func (e SharedProjectValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AllProjectsRequestValidationError) Key() bool { return e.key }
+func (e SharedProjectValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AllProjectsRequestValidationError) ErrorName() string {
-	return "AllProjectsRequestValidationError"
-}
+func (e SharedProjectValidationError) ErrorName() string { return "SharedProjectValidationError" }
 
 // Error satisfies the builtin error interface
-func (e AllProjectsRequestValidationError) Error() string {
+func (e SharedProjectValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -950,14 +15589,14 @@ func (e AllProjectsRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAllProjectsRequest.%s: %s%s",
+		"invalid %sSharedProject.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AllProjectsRequestValidationError{}
+var _ error = SharedProjectValidationError{}
 
 var _ interface {
 	Field() string
@@ -965,66 +15604,108 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AllProjectsRequestValidationError{}
+} = SharedProjectValidationError{}
 
-// Validate checks the field values on GetProjectRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *GetProjectRequest) Validate() error {
+// Validate checks the field values on Team with the rules defined in the proto
+// definition for this message. If any rules are violated, the first error
+// encountered is returned, or nil if there are no violations.
+func (m *Team) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetProjectRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// GetProjectRequestMultiError, or nil if none found.
-func (m *GetProjectRequest) ValidateAll() error {
+// ValidateAll checks the field values on Team with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in TeamMultiError, or nil if none found.
+func (m *Team) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetProjectRequest) validate(all bool) error {
+func (m *Team) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if len(m.GetUserId()) < 1 {
-		err := GetProjectRequestValidationError{
-			field:  "UserId",
-			reason: "value length must be at least 1 bytes",
+	// no validation rules for Id
+
+	// no validation rules for Name
+
+	// no validation rules for OwnerId
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TeamValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TeamValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
 		}
-		if !all {
-			return err
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TeamValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
 		}
-		errors = append(errors, err)
 	}
 
-	if len(m.GetProjectId()) < 1 {
-		err := GetProjectRequestValidationError{
-			field:  "ProjectId",
-			reason: "value length must be at least 1 bytes",
+	if all {
+		switch v := interface{}(m.GetUpdatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TeamValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TeamValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
 		}
-		if !all {
-			return err
+	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TeamValidationError{
+				field:  "UpdatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
 		}
-		errors = append(errors, err)
 	}
 
+	// no validation rules for Version
+
 	if len(errors) > 0 {
-		return GetProjectRequestMultiError(errors)
+		return TeamMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetProjectRequestMultiError is an error wrapping multiple validation errors
-// returned by GetProjectRequest.ValidateAll() if the designated constraints
-// aren't met.
-type GetProjectRequestMultiError []error
+// TeamMultiError is an error wrapping multiple validation errors returned by
+// Team.ValidateAll() if the designated constraints aren't met.
+type TeamMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetProjectRequestMultiError) Error() string {
+func (m TeamMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1033,11 +15714,11 @@ func (m GetProjectRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetProjectRequestMultiError) AllErrors() []error { return m }
+func (m TeamMultiError) AllErrors() []error { return m }
 
-// GetProjectRequestValidationError is the validation error returned by
-// GetProjectRequest.Validate if the designated constraints aren't met.
-type GetProjectRequestValidationError struct {
+// TeamValidationError is the validation error returned by Team.Validate if the
+// designated constraints aren't met.
+type TeamValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1045,24 +15726,22 @@ type GetProjectRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetProjectRequestValidationError) Field() string { return e.field }
+func (e TeamValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetProjectRequestValidationError) Reason() string { return e.reason }
+func (e TeamValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetProjectRequestValidationError) Cause() error { return e.cause }
+func (e TeamValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetProjectRequestValidationError) Key() bool { return e.key }
+func (e TeamValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetProjectRequestValidationError) ErrorName() string {
-	return "GetProjectRequestValidationError"
-}
+func (e TeamValidationError) ErrorName() string { return "TeamValidationError" }
 
 // Error satisfies the builtin error interface
-func (e GetProjectRequestValidationError) Error() string {
+func (e TeamValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1074,14 +15753,14 @@ func (e GetProjectRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetProjectRequest.%s: %s%s",
+		"invalid %sTeam.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetProjectRequestValidationError{}
+var _ error = TeamValidationError{}
 
 var _ interface {
 	Field() string
@@ -1089,78 +15768,66 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetProjectRequestValidationError{}
+} = TeamValidationError{}
 
-// Validate checks the field values on AllProjectsResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AllProjectsResponse) Validate() error {
+// Validate checks the field values on CreateTeamRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *CreateTeamRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AllProjectsResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// AllProjectsResponseMultiError, or nil if none found.
-func (m *AllProjectsResponse) ValidateAll() error {
+// ValidateAll checks the field values on CreateTeamRequest with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the result is a list of violation errors wrapped in
+// CreateTeamRequestMultiError, or nil if none found.
+func (m *CreateTeamRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AllProjectsResponse) validate(all bool) error {
+func (m *CreateTeamRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetProjects() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, AllProjectsResponseValidationError{
-						field:  fmt.Sprintf("Projects[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, AllProjectsResponseValidationError{
-						field:  fmt.Sprintf("Projects[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return AllProjectsResponseValidationError{
-					field:  fmt.Sprintf("Projects[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
+	if len(m.GetUserId()) < 1 {
+		err := CreateTeamRequestValidationError{
+			field:  "UserId",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
 		}
+		errors = append(errors, err)
+	}
 
+	if len(m.GetName()) < 1 {
+		err := CreateTeamRequestValidationError{
+			field:  "Name",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
 	}
 
 	if len(errors) > 0 {
-		return AllProjectsResponseMultiError(errors)
+		return CreateTeamRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// AllProjectsResponseMultiError is an error wrapping multiple validation
-// errors returned by AllProjectsResponse.ValidateAll() if the designated
-// constraints aren't met.
-type AllProjectsResponseMultiError []error
+// CreateTeamRequestMultiError is an error wrapping multiple validation errors
+// returned by CreateTeamRequest.ValidateAll() if the designated constraints
+// aren't met.
+type CreateTeamRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AllProjectsResponseMultiError) Error() string {
+func (m CreateTeamRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1169,11 +15836,11 @@ func (m AllProjectsResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AllProjectsResponseMultiError) AllErrors() []error { return m }
+func (m CreateTeamRequestMultiError) AllErrors() []error { return m }
 
-// AllProjectsResponseValidationError is the validation error returned by
-// AllProjectsResponse.Validate if the designated constraints aren't met.
-type AllProjectsResponseValidationError struct {
+// CreateTeamRequestValidationError is the validation error returned by
+// CreateTeamRequest.Validate if the designated constraints aren't met.
+type CreateTeamRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1181,24 +15848,24 @@ type AllProjectsResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e AllProjectsResponseValidationError) Field() string { return e.field }
+func (e CreateTeamRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AllProjectsResponseValidationError) Reason() string { return e.reason }
+func (e CreateTeamRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AllProjectsResponseValidationError) Cause() error { return e.cause }
+func (e CreateTeamRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AllProjectsResponseValidationError) Key() bool { return e.key }
+func (e CreateTeamRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AllProjectsResponseValidationError) ErrorName() string {
-	return "AllProjectsResponseValidationError"
+func (e CreateTeamRequestValidationError) ErrorName() string {
+	return "CreateTeamRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AllProjectsResponseValidationError) Error() string {
+func (e CreateTeamRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1210,14 +15877,14 @@ func (e AllProjectsResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAllProjectsResponse.%s: %s%s",
+		"invalid %sCreateTeamRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AllProjectsResponseValidationError{}
+var _ error = CreateTeamRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1225,44 +15892,33 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AllProjectsResponseValidationError{}
+} = CreateTeamRequestValidationError{}
 
-// Validate checks the field values on AddTaskRequest with the rules defined in
+// Validate checks the field values on GetTeamRequest with the rules defined in
 // the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *AddTaskRequest) Validate() error {
+func (m *GetTeamRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AddTaskRequest with the rules defined
+// ValidateAll checks the field values on GetTeamRequest with the rules defined
 // in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in AddTaskRequestMultiError,
-// or nil if none found.
-func (m *AddTaskRequest) ValidateAll() error {
+// result is a list of violation errors wrapped in GetTeamRequestMultiError, or
+// nil if none found.
+func (m *GetTeamRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AddTaskRequest) validate(all bool) error {
+func (m *GetTeamRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if len(m.GetTitle()) < 1 {
-		err := AddTaskRequestValidationError{
-			field:  "Title",
-			reason: "value length must be at least 1 bytes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if len(m.GetProjectId()) < 1 {
-		err := AddTaskRequestValidationError{
-			field:  "ProjectId",
+	if len(m.GetTeamId()) < 1 {
+		err := GetTeamRequestValidationError{
+			field:  "TeamId",
 			reason: "value length must be at least 1 bytes",
 		}
 		if !all {
@@ -1272,7 +15928,7 @@ func (m *AddTaskRequest) validate(all bool) error {
 	}
 
 	if len(m.GetUserId()) < 1 {
-		err := AddTaskRequestValidationError{
+		err := GetTeamRequestValidationError{
 			field:  "UserId",
 			reason: "value length must be at least 1 bytes",
 		}
@@ -1282,24 +15938,20 @@ func (m *AddTaskRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	// no validation rules for Description
-
-	// no validation rules for IsImportant
-
 	if len(errors) > 0 {
-		return AddTaskRequestMultiError(errors)
+		return GetTeamRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// AddTaskRequestMultiError is an error wrapping multiple validation errors
-// returned by AddTaskRequest.ValidateAll() if the designated constraints
+// GetTeamRequestMultiError is an error wrapping multiple validation errors
+// returned by GetTeamRequest.ValidateAll() if the designated constraints
 // aren't met.
-type AddTaskRequestMultiError []error
+type GetTeamRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AddTaskRequestMultiError) Error() string {
+func (m GetTeamRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1308,11 +15960,11 @@ func (m AddTaskRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AddTaskRequestMultiError) AllErrors() []error { return m }
+func (m GetTeamRequestMultiError) AllErrors() []error { return m }
 
-// AddTaskRequestValidationError is the validation error returned by
-// AddTaskRequest.Validate if the designated constraints aren't met.
-type AddTaskRequestValidationError struct {
+// GetTeamRequestValidationError is the validation error returned by
+// GetTeamRequest.Validate if the designated constraints aren't met.
+type GetTeamRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1320,22 +15972,22 @@ type AddTaskRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e AddTaskRequestValidationError) Field() string { return e.field }
+func (e GetTeamRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AddTaskRequestValidationError) Reason() string { return e.reason }
+func (e GetTeamRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AddTaskRequestValidationError) Cause() error { return e.cause }
+func (e GetTeamRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AddTaskRequestValidationError) Key() bool { return e.key }
+func (e GetTeamRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AddTaskRequestValidationError) ErrorName() string { return "AddTaskRequestValidationError" }
+func (e GetTeamRequestValidationError) ErrorName() string { return "GetTeamRequestValidationError" }
 
 // Error satisfies the builtin error interface
-func (e AddTaskRequestValidationError) Error() string {
+func (e GetTeamRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1347,14 +15999,14 @@ func (e AddTaskRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAddTaskRequest.%s: %s%s",
+		"invalid %sGetTeamRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AddTaskRequestValidationError{}
+var _ error = GetTeamRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1362,54 +16014,32 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AddTaskRequestValidationError{}
+} = GetTeamRequestValidationError{}
 
-// Validate checks the field values on UpdateTaskRequest with the rules defined
+// Validate checks the field values on ListTeamsRequest with the rules defined
 // in the proto definition for this message. If any rules are violated, the
 // first error encountered is returned, or nil if there are no violations.
-func (m *UpdateTaskRequest) Validate() error {
+func (m *ListTeamsRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UpdateTaskRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// UpdateTaskRequestMultiError, or nil if none found.
-func (m *UpdateTaskRequest) ValidateAll() error {
-	return m.validate(true)
-}
-
-func (m *UpdateTaskRequest) validate(all bool) error {
-	if m == nil {
-		return nil
-	}
-
-	var errors []error
-
-	if len(m.GetTaskId()) < 1 {
-		err := UpdateTaskRequestValidationError{
-			field:  "TaskId",
-			reason: "value length must be at least 1 bytes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if len(m.GetProjectId()) < 1 {
-		err := UpdateTaskRequestValidationError{
-			field:  "ProjectId",
-			reason: "value length must be at least 1 bytes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
+// ValidateAll checks the field values on ListTeamsRequest with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the result is a list of violation errors wrapped in
+// ListTeamsRequestMultiError, or nil if none found.
+func (m *ListTeamsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListTeamsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
 	}
 
+	var errors []error
+
 	if len(m.GetUserId()) < 1 {
-		err := UpdateTaskRequestValidationError{
+		err := ListTeamsRequestValidationError{
 			field:  "UserId",
 			reason: "value length must be at least 1 bytes",
 		}
@@ -1419,57 +16049,20 @@ func (m *UpdateTaskRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	// no validation rules for Title
-
-	// no validation rules for Description
-
-	// no validation rules for IsImportant
-
-	// no validation rules for IsFinished
-
-	if all {
-		switch v := interface{}(m.GetFieldMask()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, UpdateTaskRequestValidationError{
-					field:  "FieldMask",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, UpdateTaskRequestValidationError{
-					field:  "FieldMask",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetFieldMask()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return UpdateTaskRequestValidationError{
-				field:  "FieldMask",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
 	if len(errors) > 0 {
-		return UpdateTaskRequestMultiError(errors)
+		return ListTeamsRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// UpdateTaskRequestMultiError is an error wrapping multiple validation errors
-// returned by UpdateTaskRequest.ValidateAll() if the designated constraints
+// ListTeamsRequestMultiError is an error wrapping multiple validation errors
+// returned by ListTeamsRequest.ValidateAll() if the designated constraints
 // aren't met.
-type UpdateTaskRequestMultiError []error
+type ListTeamsRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UpdateTaskRequestMultiError) Error() string {
+func (m ListTeamsRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1478,11 +16071,11 @@ func (m UpdateTaskRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UpdateTaskRequestMultiError) AllErrors() []error { return m }
+func (m ListTeamsRequestMultiError) AllErrors() []error { return m }
 
-// UpdateTaskRequestValidationError is the validation error returned by
-// UpdateTaskRequest.Validate if the designated constraints aren't met.
-type UpdateTaskRequestValidationError struct {
+// ListTeamsRequestValidationError is the validation error returned by
+// ListTeamsRequest.Validate if the designated constraints aren't met.
+type ListTeamsRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1490,24 +16083,24 @@ type UpdateTaskRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpdateTaskRequestValidationError) Field() string { return e.field }
+func (e ListTeamsRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpdateTaskRequestValidationError) Reason() string { return e.reason }
+func (e ListTeamsRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpdateTaskRequestValidationError) Cause() error { return e.cause }
+func (e ListTeamsRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpdateTaskRequestValidationError) Key() bool { return e.key }
+func (e ListTeamsRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpdateTaskRequestValidationError) ErrorName() string {
-	return "UpdateTaskRequestValidationError"
+func (e ListTeamsRequestValidationError) ErrorName() string {
+	return "ListTeamsRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UpdateTaskRequestValidationError) Error() string {
+func (e ListTeamsRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1519,14 +16112,14 @@ func (e UpdateTaskRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdateTaskRequest.%s: %s%s",
+		"invalid %sListTeamsRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpdateTaskRequestValidationError{}
+var _ error = ListTeamsRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1534,77 +16127,78 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpdateTaskRequestValidationError{}
+} = ListTeamsRequestValidationError{}
 
-// Validate checks the field values on DeleteTaskRequest with the rules defined
+// Validate checks the field values on ListTeamsResponse with the rules defined
 // in the proto definition for this message. If any rules are violated, the
 // first error encountered is returned, or nil if there are no violations.
-func (m *DeleteTaskRequest) Validate() error {
+func (m *ListTeamsResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeleteTaskRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// DeleteTaskRequestMultiError, or nil if none found.
-func (m *DeleteTaskRequest) ValidateAll() error {
+// ValidateAll checks the field values on ListTeamsResponse with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the result is a list of violation errors wrapped in
+// ListTeamsResponseMultiError, or nil if none found.
+func (m *ListTeamsResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeleteTaskRequest) validate(all bool) error {
+func (m *ListTeamsResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if len(m.GetProjectId()) < 1 {
-		err := DeleteTaskRequestValidationError{
-			field:  "ProjectId",
-			reason: "value length must be at least 1 bytes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	for idx, item := range m.GetTeams() {
+		_, _ = idx, item
 
-	if len(m.GetTaskId()) < 1 {
-		err := DeleteTaskRequestValidationError{
-			field:  "TaskId",
-			reason: "value length must be at least 1 bytes",
-		}
-		if !all {
-			return err
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListTeamsResponseValidationError{
+						field:  fmt.Sprintf("Teams[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListTeamsResponseValidationError{
+						field:  fmt.Sprintf("Teams[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListTeamsResponseValidationError{
+					field:  fmt.Sprintf("Teams[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
 		}
-		errors = append(errors, err)
-	}
 
-	if len(m.GetUserId()) < 1 {
-		err := DeleteTaskRequestValidationError{
-			field:  "UserId",
-			reason: "value length must be at least 1 bytes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
 	}
 
 	if len(errors) > 0 {
-		return DeleteTaskRequestMultiError(errors)
+		return ListTeamsResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// DeleteTaskRequestMultiError is an error wrapping multiple validation errors
-// returned by DeleteTaskRequest.ValidateAll() if the designated constraints
+// ListTeamsResponseMultiError is an error wrapping multiple validation errors
+// returned by ListTeamsResponse.ValidateAll() if the designated constraints
 // aren't met.
-type DeleteTaskRequestMultiError []error
+type ListTeamsResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeleteTaskRequestMultiError) Error() string {
+func (m ListTeamsResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1613,11 +16207,11 @@ func (m DeleteTaskRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeleteTaskRequestMultiError) AllErrors() []error { return m }
+func (m ListTeamsResponseMultiError) AllErrors() []error { return m }
 
-// DeleteTaskRequestValidationError is the validation error returned by
-// DeleteTaskRequest.Validate if the designated constraints aren't met.
-type DeleteTaskRequestValidationError struct {
+// ListTeamsResponseValidationError is the validation error returned by
+// ListTeamsResponse.Validate if the designated constraints aren't met.
+type ListTeamsResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1625,24 +16219,24 @@ type DeleteTaskRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeleteTaskRequestValidationError) Field() string { return e.field }
+func (e ListTeamsResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeleteTaskRequestValidationError) Reason() string { return e.reason }
+func (e ListTeamsResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeleteTaskRequestValidationError) Cause() error { return e.cause }
+func (e ListTeamsResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeleteTaskRequestValidationError) Key() bool { return e.key }
+func (e ListTeamsResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeleteTaskRequestValidationError) ErrorName() string {
-	return "DeleteTaskRequestValidationError"
+func (e ListTeamsResponseValidationError) ErrorName() string {
+	return "ListTeamsResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DeleteTaskRequestValidationError) Error() string {
+func (e ListTeamsResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1654,14 +16248,14 @@ func (e DeleteTaskRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteTaskRequest.%s: %s%s",
+		"invalid %sListTeamsResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeleteTaskRequestValidationError{}
+var _ error = ListTeamsResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -1669,33 +16263,33 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeleteTaskRequestValidationError{}
+} = ListTeamsResponseValidationError{}
 
-// Validate checks the field values on DeleteProjectRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DeleteProjectRequest) Validate() error {
+// Validate checks the field values on UpdateTeamRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *UpdateTeamRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeleteProjectRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// DeleteProjectRequestMultiError, or nil if none found.
-func (m *DeleteProjectRequest) ValidateAll() error {
+// ValidateAll checks the field values on UpdateTeamRequest with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the result is a list of violation errors wrapped in
+// UpdateTeamRequestMultiError, or nil if none found.
+func (m *UpdateTeamRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeleteProjectRequest) validate(all bool) error {
+func (m *UpdateTeamRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if len(m.GetProjectId()) < 1 {
-		err := DeleteProjectRequestValidationError{
-			field:  "ProjectId",
+	if len(m.GetTeamId()) < 1 {
+		err := UpdateTeamRequestValidationError{
+			field:  "TeamId",
 			reason: "value length must be at least 1 bytes",
 		}
 		if !all {
@@ -1705,7 +16299,7 @@ func (m *DeleteProjectRequest) validate(all bool) error {
 	}
 
 	if len(m.GetUserId()) < 1 {
-		err := DeleteProjectRequestValidationError{
+		err := UpdateTeamRequestValidationError{
 			field:  "UserId",
 			reason: "value length must be at least 1 bytes",
 		}
@@ -1715,20 +16309,51 @@ func (m *DeleteProjectRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	// no validation rules for Name
+
+	if all {
+		switch v := interface{}(m.GetFieldMask()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateTeamRequestValidationError{
+					field:  "FieldMask",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateTeamRequestValidationError{
+					field:  "FieldMask",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFieldMask()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateTeamRequestValidationError{
+				field:  "FieldMask",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
-		return DeleteProjectRequestMultiError(errors)
+		return UpdateTeamRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// DeleteProjectRequestMultiError is an error wrapping multiple validation
-// errors returned by DeleteProjectRequest.ValidateAll() if the designated
-// constraints aren't met.
-type DeleteProjectRequestMultiError []error
+// UpdateTeamRequestMultiError is an error wrapping multiple validation errors
+// returned by UpdateTeamRequest.ValidateAll() if the designated constraints
+// aren't met.
+type UpdateTeamRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeleteProjectRequestMultiError) Error() string {
+func (m UpdateTeamRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1737,11 +16362,11 @@ func (m DeleteProjectRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeleteProjectRequestMultiError) AllErrors() []error { return m }
+func (m UpdateTeamRequestMultiError) AllErrors() []error { return m }
 
-// DeleteProjectRequestValidationError is the validation error returned by
-// DeleteProjectRequest.Validate if the designated constraints aren't met.
-type DeleteProjectRequestValidationError struct {
+// UpdateTeamRequestValidationError is the validation error returned by
+// UpdateTeamRequest.Validate if the designated constraints aren't met.
+type UpdateTeamRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1749,24 +16374,24 @@ type DeleteProjectRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeleteProjectRequestValidationError) Field() string { return e.field }
+func (e UpdateTeamRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeleteProjectRequestValidationError) Reason() string { return e.reason }
+func (e UpdateTeamRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeleteProjectRequestValidationError) Cause() error { return e.cause }
+func (e UpdateTeamRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeleteProjectRequestValidationError) Key() bool { return e.key }
+func (e UpdateTeamRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeleteProjectRequestValidationError) ErrorName() string {
-	return "DeleteProjectRequestValidationError"
+func (e UpdateTeamRequestValidationError) ErrorName() string {
+	return "UpdateTeamRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DeleteProjectRequestValidationError) Error() string {
+func (e UpdateTeamRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1778,14 +16403,14 @@ func (e DeleteProjectRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteProjectRequest.%s: %s%s",
+		"invalid %sUpdateTeamRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeleteProjectRequestValidationError{}
+var _ error = UpdateTeamRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1793,33 +16418,33 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeleteProjectRequestValidationError{}
+} = UpdateTeamRequestValidationError{}
 
-// Validate checks the field values on ProjectsUpdatesRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ProjectsUpdatesRequest) Validate() error {
+// Validate checks the field values on DeleteTeamRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *DeleteTeamRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ProjectsUpdatesRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// ProjectsUpdatesRequestMultiError, or nil if none found.
-func (m *ProjectsUpdatesRequest) ValidateAll() error {
+// ValidateAll checks the field values on DeleteTeamRequest with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the result is a list of violation errors wrapped in
+// DeleteTeamRequestMultiError, or nil if none found.
+func (m *DeleteTeamRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ProjectsUpdatesRequest) validate(all bool) error {
+func (m *DeleteTeamRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if len(m.GetUserId()) < 1 {
-		err := ProjectsUpdatesRequestValidationError{
-			field:  "UserId",
+	if len(m.GetTeamId()) < 1 {
+		err := DeleteTeamRequestValidationError{
+			field:  "TeamId",
 			reason: "value length must be at least 1 bytes",
 		}
 		if !all {
@@ -1828,9 +16453,9 @@ func (m *ProjectsUpdatesRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if len(m.GetDeviceId()) < 1 {
-		err := ProjectsUpdatesRequestValidationError{
-			field:  "DeviceId",
+	if len(m.GetUserId()) < 1 {
+		err := DeleteTeamRequestValidationError{
+			field:  "UserId",
 			reason: "value length must be at least 1 bytes",
 		}
 		if !all {
@@ -1840,19 +16465,19 @@ func (m *ProjectsUpdatesRequest) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return ProjectsUpdatesRequestMultiError(errors)
+		return DeleteTeamRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// ProjectsUpdatesRequestMultiError is an error wrapping multiple validation
-// errors returned by ProjectsUpdatesRequest.ValidateAll() if the designated
-// constraints aren't met.
-type ProjectsUpdatesRequestMultiError []error
+// DeleteTeamRequestMultiError is an error wrapping multiple validation errors
+// returned by DeleteTeamRequest.ValidateAll() if the designated constraints
+// aren't met.
+type DeleteTeamRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ProjectsUpdatesRequestMultiError) Error() string {
+func (m DeleteTeamRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1861,11 +16486,11 @@ func (m ProjectsUpdatesRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ProjectsUpdatesRequestMultiError) AllErrors() []error { return m }
+func (m DeleteTeamRequestMultiError) AllErrors() []error { return m }
 
-// ProjectsUpdatesRequestValidationError is the validation error returned by
-// ProjectsUpdatesRequest.Validate if the designated constraints aren't met.
-type ProjectsUpdatesRequestValidationError struct {
+// DeleteTeamRequestValidationError is the validation error returned by
+// DeleteTeamRequest.Validate if the designated constraints aren't met.
+type DeleteTeamRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1873,24 +16498,24 @@ type ProjectsUpdatesRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ProjectsUpdatesRequestValidationError) Field() string { return e.field }
+func (e DeleteTeamRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ProjectsUpdatesRequestValidationError) Reason() string { return e.reason }
+func (e DeleteTeamRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ProjectsUpdatesRequestValidationError) Cause() error { return e.cause }
+func (e DeleteTeamRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ProjectsUpdatesRequestValidationError) Key() bool { return e.key }
+func (e DeleteTeamRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ProjectsUpdatesRequestValidationError) ErrorName() string {
-	return "ProjectsUpdatesRequestValidationError"
+func (e DeleteTeamRequestValidationError) ErrorName() string {
+	return "DeleteTeamRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ProjectsUpdatesRequestValidationError) Error() string {
+func (e DeleteTeamRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1902,14 +16527,14 @@ func (e ProjectsUpdatesRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sProjectsUpdatesRequest.%s: %s%s",
+		"invalid %sDeleteTeamRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ProjectsUpdatesRequestValidationError{}
+var _ error = DeleteTeamRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1917,4 +16542,4 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ProjectsUpdatesRequestValidationError{}
+} = DeleteTeamRequestValidationError{}
@@ -0,0 +1,79 @@
+package todopb
+
+import (
+	"sort"
+
+	"github.com/rs/xid"
+	"github.com/sladonia/todo-sv/pkg/set"
+)
+
+// ChildParticipants returns the participants inherited by the children of
+// the project.
+func (x *Project) ChildParticipants() []string {
+	participants := x.ParticipantsIDs()
+	sort.Strings(participants)
+
+	return participants
+}
+
+// WithParent moves the project under the parent. A nil parent moves the
+// project to the top level.
+func (x *Project) WithParent(parent *Project) *Project {
+	updated := x.clone()
+
+	updated.ParentId = ""
+	updated.InheritedParticipants = nil
+	updated.InheritedRoles = nil
+
+	if parent != nil {
+		updated.ParentId = parent.Id
+		updated.InheritedParticipants = parent.ChildParticipants()
+		updated.InheritedRoles = parent.ChildRoles()
+	}
+
+	updated.Version = xid.New().String()
+	updated.UpdatedAt = timestampNowMilliseconds()
+
+	return updated
+}
+
+// WithInheritedMembers returns the project with the given inherited
+// participants and roles and whether they differ from the current ones.
+func (x *Project) WithInheritedMembers(participants []string, roles map[string]Role) (*Project, bool) {
+	if set.NewSet(x.InheritedParticipants...).IsEqual(set.NewSet(participants...)) &&
+		rolesEqual(x.InheritedRoles, roles) {
+		return x, false
+	}
+
+	updated := x.clone()
+
+	updated.InheritedParticipants = cloneStrings(participants)
+	updated.InheritedRoles = cloneRoles(roles)
+	updated.Version = xid.New().String()
+	updated.UpdatedAt = timestampNowMilliseconds()
+
+	return updated, true
+}
+
+// NewProjectTree arranges the projects by their parents. Projects whose parent
+// is not among the given ones become roots.
+func NewProjectTree(projects []*Project) *ProjectTree {
+	nodes := make(map[string]*ProjectTreeNode, len(projects))
+	for _, p := range projects {
+		nodes[p.Id] = &ProjectTreeNode{Project: p}
+	}
+
+	tree := &ProjectTree{}
+
+	for _, p := range projects {
+		parent, ok := nodes[p.ParentId]
+		if !ok || p.ParentId == p.Id {
+			tree.Roots = append(tree.Roots, nodes[p.Id])
+			continue
+		}
+
+		parent.Children = append(parent.Children, nodes[p.Id])
+	}
+
+	return tree
+}